@@ -0,0 +1,199 @@
+package gostrutures
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrQueueClosed is returned when putting into a closed queue or taking from a closed and empty queue.
+var ErrQueueClosed = errors.New("gostrutures: queue closed")
+
+// BlockingQueue is a bounded FIFO queue safe for concurrent use by multiple goroutines,
+// meant for producer/consumer pipelines.
+//
+// Put blocks while the queue is full and Take blocks while the queue is empty. Once
+// closed, the puts fail and the takes keep returning the remaining items until the
+// queue is empty.
+type BlockingQueue struct {
+	mu       sync.Mutex
+	ring     ring[Item]
+	capacity int
+	closed   bool
+	// notEmpty and notFull are closed and replaced to wake up all the goroutines waiting for them.
+	notEmpty       chan struct{}
+	notFull        chan struct{}
+	waitingTakers  int
+	waitingPutters int
+}
+
+// NewBlockingQueue build a BlockingQueue that holds at most capacity items. It panics if
+// capacity is not positive.
+func NewBlockingQueue(capacity int) *BlockingQueue {
+	if capacity <= 0 {
+		panic("gostrutures: BlockingQueue capacity must be positive")
+	}
+
+	return &BlockingQueue{
+		ring:     newRing[Item](capacity, false),
+		capacity: capacity,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
+
+// Put adds an element (Item) to the end of the queue, waiting while the queue is full.
+// Return ErrQueueClosed if the queue is closed.
+func (q *BlockingQueue) Put(value Item) error {
+	return q.PutCtx(context.Background(), value)
+}
+
+// PutCtx adds an element (Item) to the end of the queue, waiting while the queue is full
+// until the context is done. Return ErrQueueClosed if the queue is closed or the context
+// error if it's done before the item is added.
+func (q *BlockingQueue) PutCtx(ctx context.Context, value Item) error {
+	q.mu.Lock()
+	for {
+		if q.closed {
+			q.mu.Unlock()
+			return ErrQueueClosed
+		}
+
+		if q.ring.size < q.capacity {
+			q.ring.push(value)
+			if q.waitingTakers > 0 {
+				q.notEmpty = broadcast(q.notEmpty)
+			}
+			q.mu.Unlock()
+			return nil
+		}
+
+		if err := q.wait(ctx, q.notFull, &q.waitingPutters); err != nil {
+			q.mu.Unlock()
+			return err
+		}
+	}
+}
+
+// Offer adds an element (Item) to the end of the queue, waiting at most timeout while the
+// queue is full. Return false if the item was not added.
+func (q *BlockingQueue) Offer(value Item, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.PutCtx(ctx, value) == nil
+}
+
+// Take retrieves and removes the head of the queue, waiting while the queue is empty.
+// Return ErrQueueClosed if the queue is closed and empty.
+func (q *BlockingQueue) Take() (Item, error) {
+	return q.TakeCtx(context.Background())
+}
+
+// TakeCtx retrieves and removes the head of the queue, waiting while the queue is empty
+// until the context is done. Return ErrQueueClosed if the queue is closed and empty or
+// the context error if it's done before an item is available.
+func (q *BlockingQueue) TakeCtx(ctx context.Context) (Item, error) {
+	q.mu.Lock()
+	for {
+		if item, ok := q.ring.pop(); ok {
+			if q.waitingPutters > 0 {
+				q.notFull = broadcast(q.notFull)
+			}
+			q.mu.Unlock()
+			return item, nil
+		}
+
+		if q.closed {
+			q.mu.Unlock()
+			return nil, ErrQueueClosed
+		}
+
+		if err := q.wait(ctx, q.notEmpty, &q.waitingTakers); err != nil {
+			q.mu.Unlock()
+			return nil, err
+		}
+	}
+}
+
+// Poll retrieves and removes the head of the queue, waiting at most timeout while the
+// queue is empty. Return false if no item was available.
+func (q *BlockingQueue) Poll(timeout time.Duration) (Item, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	item, err := q.TakeCtx(ctx)
+	return item, err == nil
+}
+
+// DrainTo removes without waiting as many items as available, up to len(dst), and stores
+// them in dst. Returns the number of items stored.
+func (q *BlockingQueue) DrainTo(dst []Item) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := 0
+	for n < len(dst) {
+		item, ok := q.ring.pop()
+		if !ok {
+			break
+		}
+		dst[n] = item
+		n++
+	}
+
+	if n > 0 && q.waitingPutters > 0 {
+		q.notFull = broadcast(q.notFull)
+	}
+	return n
+}
+
+// Close closes the queue and wakes up all the waiting goroutines. Closing an already
+// closed queue has no effect.
+func (q *BlockingQueue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.notEmpty = broadcast(q.notEmpty)
+	q.notFull = broadcast(q.notFull)
+}
+
+// Size returns the number of Items in the queue
+func (q *BlockingQueue) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.ring.size
+}
+
+// Cap returns the maximum number of Items the queue can hold.
+func (q *BlockingQueue) Cap() int {
+	return q.capacity
+}
+
+// wait releases the lock until the signal is broadcast or the context is done, and then
+// acquires it again. Return the context error if it's done. While waiting, the goroutine
+// is counted in waiters so the signal is only broadcast when someone is waiting for it.
+func (q *BlockingQueue) wait(ctx context.Context, signal <-chan struct{}, waiters *int) error {
+	*waiters++
+	q.mu.Unlock()
+
+	var err error
+	select {
+	case <-signal:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	q.mu.Lock()
+	*waiters--
+	return err
+}
+
+// broadcast wakes up all the goroutines waiting for signal and returns a new signal.
+func broadcast(signal chan struct{}) chan struct{} {
+	close(signal)
+	return make(chan struct{})
+}
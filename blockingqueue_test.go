@@ -0,0 +1,231 @@
+package gostrutures_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func TestNewBlockingQueuePanicsWithoutCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected NewBlockingQueue with zero capacity to panic")
+		}
+	}()
+	gostrutures.NewBlockingQueue(0)
+}
+
+func TestBlockingQueuePutTake(t *testing.T) {
+	q := gostrutures.NewBlockingQueue(3)
+	for _, v := range []int{1, 2, 3} {
+		if err := q.Put(v); err != nil {
+			t.Fatalf("Expected put error to be nil. Got '%v'", err)
+		}
+	}
+
+	if q.Size() != 3 {
+		t.Errorf("Expected queue size to be '%v'. Got '%v'", 3, q.Size())
+	}
+
+	for _, expected := range []int{1, 2, 3} {
+		result, err := q.Take()
+		if err != nil || result != expected {
+			t.Fatalf("Expected take to be '%v, nil'. Got '%v, %v'", expected, result, err)
+		}
+	}
+}
+
+func TestBlockingQueueOfferWhenFull(t *testing.T) {
+	q := gostrutures.NewBlockingQueue(1)
+	if !q.Offer(1, time.Millisecond) {
+		t.Fatal("Expected offer into an empty queue to succeed")
+	}
+
+	if q.Offer(2, 10*time.Millisecond) {
+		t.Error("Expected offer into a full queue to fail")
+	}
+}
+
+func TestBlockingQueuePollWhenEmpty(t *testing.T) {
+	q := gostrutures.NewBlockingQueue(1)
+	if result, ok := q.Poll(10 * time.Millisecond); ok {
+		t.Errorf("Expected poll from an empty queue to fail. Got '%v'", result)
+	}
+}
+
+func TestBlockingQueueContextCanceled(t *testing.T) {
+	q := gostrutures.NewBlockingQueue(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := q.TakeCtx(ctx); err != context.Canceled {
+		t.Errorf("Expected take error to be '%v'. Got '%v'", context.Canceled, err)
+	}
+
+	q.Put(1)
+	if err := q.PutCtx(ctx, 2); err != context.Canceled {
+		t.Errorf("Expected put error to be '%v'. Got '%v'", context.Canceled, err)
+	}
+}
+
+func TestBlockingQueuePutBlocksUntilTake(t *testing.T) {
+	q := gostrutures.NewBlockingQueue(1)
+	q.Put(1)
+
+	done := make(chan error)
+	go func() {
+		done <- q.Put(2)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Expected put into a full queue to block")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	if result, _ := q.Take(); result != 1 {
+		t.Errorf("Expected take to be '%v'. Got '%v'", 1, result)
+	}
+
+	if err := <-done; err != nil {
+		t.Errorf("Expected put error to be nil. Got '%v'", err)
+	}
+
+	if result, _ := q.Take(); result != 2 {
+		t.Errorf("Expected take to be '%v'. Got '%v'", 2, result)
+	}
+}
+
+func TestBlockingQueueCloseWakesWaiters(t *testing.T) {
+	q := gostrutures.NewBlockingQueue(1)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := q.Take()
+			errs <- err
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != gostrutures.ErrQueueClosed {
+			t.Errorf("Expected take error to be '%v'. Got '%v'", gostrutures.ErrQueueClosed, err)
+		}
+	}
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	q := gostrutures.NewBlockingQueue(2)
+	q.Put(1)
+	q.Close()
+	q.Close()
+
+	if err := q.Put(2); err != gostrutures.ErrQueueClosed {
+		t.Errorf("Expected put error to be '%v'. Got '%v'", gostrutures.ErrQueueClosed, err)
+	}
+
+	if result, err := q.Take(); result != 1 || err != nil {
+		t.Errorf("Expected take of remaining item to be '1, nil'. Got '%v, %v'", result, err)
+	}
+
+	if _, err := q.Take(); err != gostrutures.ErrQueueClosed {
+		t.Errorf("Expected take error to be '%v'. Got '%v'", gostrutures.ErrQueueClosed, err)
+	}
+}
+
+func TestBlockingQueueDrainTo(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+		dstLen       int
+		expected     []int
+	}{
+		{"empty queue", []int{}, 3, []int{}},
+		{"less items than room", []int{1, 2}, 3, []int{1, 2}},
+		{"more items than room", []int{1, 2, 3, 4}, 3, []int{1, 2, 3}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			q := gostrutures.NewBlockingQueue(4)
+			for _, v := range tc.insertValues {
+				q.Put(v)
+			}
+
+			dst := make([]gostrutures.Item, tc.dstLen)
+			n := q.DrainTo(dst)
+			if n != len(tc.expected) {
+				t.Fatalf("Expected drained items to be '%v'. Got '%v'", len(tc.expected), n)
+			}
+
+			for i := range tc.expected {
+				if dst[i] != tc.expected[i] {
+					t.Errorf("Expected drained items to be '%v'. Got '%v'", tc.expected, dst[:n])
+					break
+				}
+			}
+
+			if q.Size() != len(tc.insertValues)-n {
+				t.Errorf("Expected queue size to be '%v'. Got '%v'", len(tc.insertValues)-n, q.Size())
+			}
+		})
+	}
+}
+
+func TestBlockingQueueProducersConsumers(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 1000
+	q := gostrutures.NewBlockingQueue(8)
+
+	var producersWg, consumersWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producersWg.Add(1)
+		go func(p int) {
+			defer producersWg.Done()
+			for i := 0; i < perProducer; i++ {
+				if err := q.Put(p*perProducer + i); err != nil {
+					t.Errorf("Expected put error to be nil. Got '%v'", err)
+					return
+				}
+			}
+		}(p)
+	}
+
+	seen := make([]int, producers*perProducer)
+	var mu sync.Mutex
+	for c := 0; c < consumers; c++ {
+		consumersWg.Add(1)
+		go func() {
+			defer consumersWg.Done()
+			for {
+				item, err := q.Take()
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				seen[item.(int)]++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	producersWg.Wait()
+	q.Close()
+	consumersWg.Wait()
+
+	for v, count := range seen {
+		if count != 1 {
+			t.Fatalf("Expected item '%v' to be taken once. Got '%v'", v, count)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package gostrutures
+
+import (
+	"sync/atomic"
+)
+
+// ConcurrentQueue is an unbounded lock-free FIFO queue safe for concurrent use by multiple
+// producers and consumers, based on the Michael-Scott queue algorithm.
+//
+// It has the same Push/Pop/Size contract as Queue. As the queue can change concurrently,
+// Size is a snapshot that may be stale by the time it's used.
+type ConcurrentQueue struct {
+	// head is a sentinel node whose next node holds the head of the queue.
+	head atomic.Pointer[concurrentNode]
+	tail atomic.Pointer[concurrentNode]
+	size atomic.Int64
+}
+
+type concurrentNode struct {
+	// value is cleared when the node becomes the sentinel, so the popped item can be garbage
+	// collected.
+	value atomic.Pointer[Item]
+	next  atomic.Pointer[concurrentNode]
+}
+
+// NewConcurrentQueue build an empty ConcurrentQueue.
+func NewConcurrentQueue() *ConcurrentQueue {
+	q := &ConcurrentQueue{}
+	sentinel := &concurrentNode{}
+	q.head.Store(sentinel)
+	q.tail.Store(sentinel)
+	return q
+}
+
+// Push adds an element (Item) to the end of the queue.
+func (q *ConcurrentQueue) Push(value Item) {
+	node := &concurrentNode{}
+	node.value.Store(&value)
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+
+		if next != nil {
+			// the tail is lagging behind, help to advance it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		if tail.next.CompareAndSwap(nil, node) {
+			q.tail.CompareAndSwap(tail, node)
+			q.size.Add(1)
+			return
+		}
+	}
+}
+
+// Pop retrieves and removes the head of this queue, or returns nil if this queue is empty.
+// Use TryPop to tell apart an empty queue from a nil Item.
+func (q *ConcurrentQueue) Pop() Item {
+	item, _ := q.TryPop()
+	return item
+}
+
+// TryPop retrieves and removes the head of this queue. Return false if this queue is empty.
+func (q *ConcurrentQueue) TryPop() (Item, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+
+		if next == nil {
+			return nil, false
+		}
+
+		if head == tail {
+			// the tail is lagging behind, help to advance it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		// the value is loaded before the CAS, as the winner clears it after
+		value := next.value.Load()
+		if q.head.CompareAndSwap(head, next) {
+			next.value.Store(nil)
+			q.size.Add(-1)
+			return *value, true
+		}
+	}
+}
+
+// Size returns the number of Items in the queue
+func (q *ConcurrentQueue) Size() int {
+	// a pop can be counted before its push, so the counter can be transiently negative
+	if size := q.size.Load(); size > 0 {
+		return int(size)
+	}
+	return 0
+}
+
+// IsEmpty returns true if the queue is empty
+func (q *ConcurrentQueue) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
@@ -0,0 +1,196 @@
+package gostrutures_test
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"weak"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func TestConcurrentQueuePushPop(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+	}{
+		{"empty queue", []int{}},
+		{"queue with elements", []int{3, 2, 3, 4}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := gostrutures.NewConcurrentQueue()
+			for _, value := range tc.insertValues {
+				queue.Push(value)
+			}
+
+			if queue.Size() != len(tc.insertValues) {
+				t.Errorf("Expected queue size to be '%v'. Got '%v'", len(tc.insertValues), queue.Size())
+			}
+
+			for _, expected := range tc.insertValues {
+				if result := queue.Pop(); result != expected {
+					t.Fatalf("Expected pop element to be '%v'. Got '%v'", expected, result)
+				}
+			}
+
+			if !queue.IsEmpty() {
+				t.Errorf("Expected queue IsEmpty to be '%v'. Got '%v'", true, queue.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestConcurrentQueueTryPop(t *testing.T) {
+	queue := gostrutures.NewConcurrentQueue()
+	queue.Push(nil)
+
+	if result, ok := queue.TryPop(); result != nil || !ok {
+		t.Errorf("Expected try pop to be 'nil, true'. Got '%v, %v'", result, ok)
+	}
+
+	if result, ok := queue.TryPop(); result != nil || ok {
+		t.Errorf("Expected try pop to be 'nil, false'. Got '%v, %v'", result, ok)
+	}
+
+	if queue.Size() != 0 {
+		t.Errorf("Expected queue size to be '%v'. Got '%v'", 0, queue.Size())
+	}
+}
+
+func TestConcurrentQueueReleasesPoppedItems(t *testing.T) {
+	queue := gostrutures.NewConcurrentQueue()
+	item := new([64]byte)
+	released := weak.Make(item)
+	queue.Push(item)
+	item = nil
+
+	if _, ok := queue.TryPop(); !ok {
+		t.Fatal("Expected try pop to be true")
+	}
+
+	// the popped node is the sentinel now, it must not keep the item alive
+	runtime.GC()
+	if released.Value() != nil {
+		t.Error("Expected the popped item to be garbage collected")
+	}
+	runtime.KeepAlive(queue)
+}
+
+func TestConcurrentQueueProducersConsumers(t *testing.T) {
+	const producers, consumers, perProducer = 8, 8, 2000
+	queue := gostrutures.NewConcurrentQueue()
+
+	var producersWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producersWg.Add(1)
+		go func(p int) {
+			defer producersWg.Done()
+			for i := 0; i < perProducer; i++ {
+				queue.Push([2]int{p, i})
+			}
+		}(p)
+	}
+
+	results := make([][][2]int, consumers)
+	var consumersWg sync.WaitGroup
+	var popped sync.WaitGroup
+	popped.Add(producers * perProducer)
+	done := make(chan struct{})
+	for c := 0; c < consumers; c++ {
+		consumersWg.Add(1)
+		go func(c int) {
+			defer consumersWg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if item, ok := queue.TryPop(); ok {
+					results[c] = append(results[c], item.([2]int))
+					popped.Done()
+				}
+			}
+		}(c)
+	}
+
+	producersWg.Wait()
+	popped.Wait()
+	close(done)
+	consumersWg.Wait()
+
+	seen := make(map[[2]int]bool)
+	for _, consumed := range results {
+		// items of the same producer must be consumed in the order they were pushed
+		last := make(map[int]int)
+		for _, item := range consumed {
+			if seen[item] {
+				t.Fatalf("Expected item '%v' to be popped once", item)
+			}
+			seen[item] = true
+
+			if previous, ok := last[item[0]]; ok && previous > item[1] {
+				t.Fatalf("Expected items of producer '%v' in order. Got '%v' after '%v'", item[0], item[1], previous)
+			}
+			last[item[0]] = item[1]
+		}
+	}
+
+	if len(seen) != producers*perProducer {
+		t.Errorf("Expected '%v' popped items. Got '%v'", producers*perProducer, len(seen))
+	}
+
+	if !queue.IsEmpty() || queue.Size() != 0 {
+		t.Errorf("Expected queue to be empty. Got size '%v'", queue.Size())
+	}
+}
+
+type mutexQueue struct {
+	mu    sync.Mutex
+	queue gostrutures.Queue
+}
+
+func (q *mutexQueue) Push(value gostrutures.Item) {
+	q.mu.Lock()
+	q.queue.Push(value)
+	q.mu.Unlock()
+}
+
+func (q *mutexQueue) Pop() gostrutures.Item {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.Pop()
+}
+
+func benchmarkContention(b *testing.B, newQueue func() benchQueue) {
+	for _, goroutines := range []int{1, 2, 4, 8, 16, 32, 64} {
+		b.Run(fmt.Sprintf("goroutines=%d", goroutines), func(b *testing.B) {
+			queue := newQueue()
+			var wg sync.WaitGroup
+			b.ReportAllocs()
+			b.ResetTimer()
+			for g := 0; g < goroutines; g++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < b.N/goroutines; i++ {
+						queue.Push(i)
+						queue.Pop()
+					}
+				}()
+			}
+			wg.Wait()
+		})
+	}
+}
+
+func BenchmarkConcurrentQueueContention(b *testing.B) {
+	benchmarkContention(b, func() benchQueue { return gostrutures.NewConcurrentQueue() })
+}
+
+func BenchmarkMutexQueueContention(b *testing.B) {
+	benchmarkContention(b, func() benchQueue { return &mutexQueue{} })
+}
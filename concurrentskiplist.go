@@ -0,0 +1,338 @@
+package gostrutures
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ConcurrentSkipListMap is an ordered map of int keys to Items safe for concurrent use by
+// multiple goroutines, based on the lazy skip list of Herlihy, Lev, Luchangco and Shavit.
+//
+// Get and Has are lock-free and never block. Put and Delete lock only the nodes around the
+// key at every level, so operations on distant keys don't contend.
+//
+// Get, Has, Put, Delete and Range are linearizable. Range visits a snapshot of the keys in
+// the range at a single point in time without locking: it scans the range twice and retries
+// until no node of the range changed between the scans, so it never delays the writers but
+// it can retry many times while the range is under constant writes.
+type ConcurrentSkipListMap struct {
+	// head is a sentinel whose next nodes are the first ones of every level.
+	head *concurrentSkipListNode
+	size atomic.Int64
+}
+
+type concurrentSkipListNode struct {
+	key   int
+	value atomic.Pointer[Item]
+	next  []atomic.Pointer[concurrentSkipListNode]
+	// mu guards the changes to next, value and marked.
+	mu sync.Mutex
+	// marked is set when the node is logically deleted, before it's unlinked.
+	marked atomic.Bool
+	// fullyLinked is set when the node is linked at every level, the moment it's in the map.
+	fullyLinked atomic.Bool
+	// version is odd while the value, the flags or the next node at the bottom level are
+	// changing and grows after every change, so Range can validate what it read.
+	version atomic.Uint64
+}
+
+// beginChange and endChange enclose a change to the state read by load. The node must be
+// locked, or not reachable yet by other writers.
+func (n *concurrentSkipListNode) beginChange() {
+	n.version.Add(1)
+}
+
+func (n *concurrentSkipListNode) endChange() {
+	n.version.Add(1)
+}
+
+// load returns the version of the node with its state at that version, waiting for a change
+// in progress to end.
+func (n *concurrentSkipListNode) load() (version uint64, next *concurrentSkipListNode, marked, inMap bool, value *Item) {
+	for {
+		version = n.version.Load()
+		if version%2 == 0 {
+			next, marked, inMap, value = n.next[0].Load(), n.marked.Load(), n.inMap(), n.value.Load()
+			if n.version.Load() == version {
+				return version, next, marked, inMap, value
+			}
+		}
+		runtime.Gosched()
+	}
+}
+
+func newConcurrentSkipListNode(key int, value Item, level int) *concurrentSkipListNode {
+	node := &concurrentSkipListNode{key: key, next: make([]atomic.Pointer[concurrentSkipListNode], level)}
+	node.value.Store(&value)
+	return node
+}
+
+// NewConcurrentSkipListMap build an empty ConcurrentSkipListMap.
+func NewConcurrentSkipListMap() *ConcurrentSkipListMap {
+	head := newConcurrentSkipListNode(0, nil, DefaultSkipListMaxLevel)
+	head.fullyLinked.Store(true)
+	return &ConcurrentSkipListMap{head: head}
+}
+
+// randomLevel returns the level of a new node like SkipList does, using the goroutine safe
+// global source.
+func (m *ConcurrentSkipListMap) randomLevel() int {
+	level := 1
+	for level < DefaultSkipListMaxLevel && rand.Float64() < DefaultSkipListProbability {
+		level++
+	}
+	return level
+}
+
+// find fills preds and succs with the last node lower than key and the one following it at
+// every level. Return the highest level where the key was found, or -1 if it wasn't.
+func (m *ConcurrentSkipListMap) find(key int, preds, succs []*concurrentSkipListNode) int {
+	found := -1
+	pred := m.head
+	for level := len(m.head.next) - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && curr.key < key {
+			pred, curr = curr, curr.next[level].Load()
+		}
+		if found == -1 && curr != nil && curr.key == key {
+			found = level
+		}
+		preds[level], succs[level] = pred, curr
+	}
+	return found
+}
+
+// lowerBound returns the first node with a key greater or equal than key, in the map or not.
+func (m *ConcurrentSkipListMap) lowerBound(key int) *concurrentSkipListNode {
+	_, curr := m.search(key)
+	return curr
+}
+
+// search returns the last node with a key lower than key at the bottom level, or head, and
+// the node that followed it. The next node of pred must not be loaded again, as a node lower
+// than key can be put between them meanwhile.
+func (m *ConcurrentSkipListMap) search(key int) (pred, curr *concurrentSkipListNode) {
+	pred = m.head
+	for level := len(m.head.next) - 1; level >= 0; level-- {
+		curr = pred.next[level].Load()
+		for curr != nil && curr.key < key {
+			pred, curr = curr, curr.next[level].Load()
+		}
+	}
+	return pred, curr
+}
+
+// inMap returns true if the node is linked and not deleted.
+func (n *concurrentSkipListNode) inMap() bool {
+	return n.fullyLinked.Load() && !n.marked.Load()
+}
+
+// Get returns the value of the key. Return false if the key is not in the map.
+func (m *ConcurrentSkipListMap) Get(key int) (Item, bool) {
+	node := m.lowerBound(key)
+	if node == nil || node.key != key || !node.inMap() {
+		return nil, false
+	}
+	return *node.value.Load(), true
+}
+
+// Has returns true if the key is in the map.
+func (m *ConcurrentSkipListMap) Has(key int) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Put sets the value of the key. Return true if the key was added and false if it was
+// already in the map and its value was replaced.
+func (m *ConcurrentSkipListMap) Put(key int, value Item) bool {
+	level := m.randomLevel()
+	var preds, succs [DefaultSkipListMaxLevel]*concurrentSkipListNode
+	for {
+		if found := m.find(key, preds[:], succs[:]); found != -1 {
+			node := succs[found]
+			// wait for a concurrent Put to finish linking it
+			for !node.fullyLinked.Load() && !node.marked.Load() {
+				runtime.Gosched()
+			}
+			node.mu.Lock()
+			if node.marked.Load() {
+				// deleted meanwhile, retry to add it again
+				node.mu.Unlock()
+				continue
+			}
+			node.beginChange()
+			node.value.Store(&value)
+			node.endChange()
+			node.mu.Unlock()
+			return false
+		}
+
+		unlock, valid := lockPredecessors(preds[:level], succs[:level], nil)
+		if !valid {
+			unlock()
+			continue
+		}
+
+		node := newConcurrentSkipListNode(key, value, level)
+		// locked until it's fully linked, so a Put after it doesn't change it meanwhile
+		node.mu.Lock()
+		for i := 0; i < level; i++ {
+			node.next[i].Store(succs[i])
+		}
+		preds[0].beginChange()
+		preds[0].next[0].Store(node)
+		preds[0].endChange()
+		for i := 1; i < level; i++ {
+			preds[i].next[i].Store(node)
+		}
+		// counted before the node can be deleted, so the size is never negative
+		m.size.Add(1)
+		node.beginChange()
+		node.fullyLinked.Store(true)
+		node.endChange()
+		node.mu.Unlock()
+		unlock()
+		return true
+	}
+}
+
+// Delete removes the key from the map. Return the value it had and false if the key was
+// not in the map.
+func (m *ConcurrentSkipListMap) Delete(key int) (Item, bool) {
+	var preds, succs [DefaultSkipListMaxLevel]*concurrentSkipListNode
+	var victim *concurrentSkipListNode
+	for {
+		found := m.find(key, preds[:], succs[:])
+		if victim == nil {
+			// only a node fully linked and found at its top level is ready to be deleted
+			if found == -1 || !succs[found].inMap() || len(succs[found].next)-1 != found {
+				return nil, false
+			}
+
+			victim = succs[found]
+			victim.mu.Lock()
+			if victim.marked.Load() {
+				victim.mu.Unlock()
+				return nil, false
+			}
+			victim.beginChange()
+			victim.marked.Store(true)
+			victim.endChange()
+			m.size.Add(-1)
+		}
+
+		level := len(victim.next)
+		unlock, valid := lockPredecessors(preds[:level], succs[:level], victim)
+		if !valid {
+			unlock()
+			continue
+		}
+
+		for i := level - 1; i > 0; i-- {
+			preds[i].next[i].Store(victim.next[i].Load())
+		}
+		preds[0].beginChange()
+		preds[0].next[0].Store(victim.next[0].Load())
+		preds[0].endChange()
+		victim.mu.Unlock()
+		unlock()
+		return *victim.value.Load(), true
+	}
+}
+
+// lockPredecessors locks the predecessors from the bottom level up, so the locks are always
+// taken in decreasing order of key, and validates that they are still in the map and followed
+// by their successors. If victim is not nil it's the successor at every level. Return the
+// function that unlocks them, that must be called even if they are not valid.
+func lockPredecessors(preds, succs []*concurrentSkipListNode, victim *concurrentSkipListNode) (func(), bool) {
+	locked := 0
+	unlock := func() {
+		var prev *concurrentSkipListNode
+		for _, pred := range preds[:locked] {
+			if pred != prev {
+				pred.mu.Unlock()
+			}
+			prev = pred
+		}
+	}
+
+	var prev *concurrentSkipListNode
+	for i, pred := range preds {
+		if pred != prev {
+			pred.mu.Lock()
+		}
+		prev = pred
+		locked++
+
+		succ := succs[i]
+		if victim != nil {
+			succ = victim
+		}
+		if pred.marked.Load() || (succ != nil && succ != victim && succ.marked.Load()) || pred.next[i].Load() != succ {
+			return unlock, false
+		}
+	}
+	return unlock, true
+}
+
+// Range visits in increasing order the keys between from and to, both included, with their
+// values, until f returns false. The keys are a snapshot of the map at a single point in time,
+// collected before calling f, so f can change the map.
+func (m *ConcurrentSkipListMap) Range(from, to int, f func(key int, value Item) bool) {
+	for _, e := range m.snapshot(from, to) {
+		if !f(e.key, e.value) {
+			return
+		}
+	}
+}
+
+type skipListEntry struct {
+	key   int
+	value Item
+}
+
+// snapshot returns the entries between from and to. It loads the nodes from the one before
+// from to the last one up to to, and loads them again until none changed. Then at any moment
+// between both loads, every node had the loaded state, so the entries were the ones in the map.
+func (m *ConcurrentSkipListMap) snapshot(from, to int) []skipListEntry {
+	var nodes []*concurrentSkipListNode
+	var versions []uint64
+	var entries []skipListEntry
+	for {
+		nodes, versions, entries = nodes[:0], versions[:0], entries[:0]
+		node, _ := m.search(from)
+		for {
+			version, next, marked, inMap, value := node.load()
+			if len(nodes) == 0 && marked {
+				// the node before the range is being deleted, it may not be linked anymore
+				break
+			}
+			nodes, versions = append(nodes, node), append(versions, version)
+			if inMap && node != m.head && node.key >= from {
+				entries = append(entries, skipListEntry{node.key, *value})
+			}
+			if next == nil || next.key > to {
+				break
+			}
+			node = next
+		}
+
+		valid := len(nodes) > 0
+		for i := 0; valid && i < len(nodes); i++ {
+			version, _, _, _, _ := nodes[i].load()
+			valid = version == versions[i]
+		}
+		if valid {
+			return entries
+		}
+		runtime.Gosched()
+	}
+}
+
+// Len returns the number of keys in the map. As the map can change concurrently, it's a
+// snapshot that may be stale by the time it's used.
+func (m *ConcurrentSkipListMap) Len() int {
+	return int(m.size.Load())
+}
@@ -0,0 +1,351 @@
+package gostrutures_test
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func rangeKeys(m *gostrutures.ConcurrentSkipListMap, from, to int) []int {
+	var keys []int
+	m.Range(from, to, func(key int, _ gostrutures.Item) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func TestConcurrentSkipListMapPutGet(t *testing.T) {
+	m := gostrutures.NewConcurrentSkipListMap()
+	tt := []struct {
+		name          string
+		key           int
+		value         gostrutures.Item
+		expectedAdded bool
+	}{
+		{"new key", 5, "a", true},
+		{"other key", -3, "b", true},
+		{"replace value", 5, "c", false},
+		{"nil value", 7, nil, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if added := m.Put(tc.key, tc.value); added != tc.expectedAdded {
+				t.Errorf("Expected put to be '%v'. Got '%v'", tc.expectedAdded, added)
+			}
+
+			if value, ok := m.Get(tc.key); value != tc.value || !ok {
+				t.Errorf("Expected get to be '%v, true'. Got '%v, %v'", tc.value, value, ok)
+			}
+		})
+	}
+
+	if value, ok := m.Get(6); value != nil || ok || m.Has(6) {
+		t.Errorf("Expected get of a missing key to be 'nil, false'. Got '%v, %v'", value, ok)
+	}
+
+	if m.Len() != 3 {
+		t.Errorf("Expected map len to be '%v'. Got '%v'", 3, m.Len())
+	}
+}
+
+func TestConcurrentSkipListMapDelete(t *testing.T) {
+	m := gostrutures.NewConcurrentSkipListMap()
+	for _, key := range []int{3, 1, 2} {
+		m.Put(key, key*10)
+	}
+
+	tt := []struct {
+		name          string
+		key           int
+		expectedValue gostrutures.Item
+		expectedOk    bool
+		expectedKeys  []int
+	}{
+		{"existing key", 2, 20, true, []int{1, 3}},
+		{"deleted key", 2, nil, false, []int{1, 3}},
+		{"missing key", 4, nil, false, []int{1, 3}},
+		{"first key", 1, 10, true, []int{3}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if value, ok := m.Delete(tc.key); value != tc.expectedValue || ok != tc.expectedOk {
+				t.Errorf("Expected delete to be '%v, %v'. Got '%v, %v'", tc.expectedValue, tc.expectedOk, value, ok)
+			}
+
+			if keys := rangeKeys(m, -100, 100); !equalIntSlices(keys, tc.expectedKeys) {
+				t.Errorf("Expected map keys to be '%v'. Got '%v'", tc.expectedKeys, keys)
+			}
+		})
+	}
+}
+
+func TestConcurrentSkipListMapRange(t *testing.T) {
+	m := gostrutures.NewConcurrentSkipListMap()
+	for key := 10; key > 0; key-- {
+		m.Put(key, key)
+	}
+
+	tt := []struct {
+		name     string
+		from, to int
+		expected []int
+	}{
+		{"inner range", 3, 6, []int{3, 4, 5, 6}},
+		{"whole map", 0, 100, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{"out of the map", 20, 30, nil},
+		{"reversed bounds", 6, 3, nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if keys := rangeKeys(m, tc.from, tc.to); !equalIntSlices(keys, tc.expected) {
+				t.Errorf("Expected range keys to be '%v'. Got '%v'", tc.expected, keys)
+			}
+		})
+	}
+
+	var visited []int
+	m.Range(0, 100, func(key int, _ gostrutures.Item) bool {
+		visited = append(visited, key)
+		return key < 2
+	})
+	if !equalIntSlices(visited, []int{1, 2}) {
+		t.Errorf("Expected range to stop when f returns false. Got '%v'", visited)
+	}
+}
+
+func TestConcurrentSkipListMapConcurrentPutDelete(t *testing.T) {
+	const goroutines, keysPerGoroutine = 16, 500
+	m := gostrutures.NewConcurrentSkipListMap()
+
+	// every goroutine owns the keys congruent with its id, so the final state is known, but
+	// the keys are interleaved so the goroutines contend for the same nodes
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(int64(g)))
+			present := make(map[int]bool)
+			for i := 0; i < 4*keysPerGoroutine; i++ {
+				key := r.Intn(keysPerGoroutine)*goroutines + g
+				if r.Intn(2) == 0 {
+					if m.Put(key, g) == present[key] {
+						t.Errorf("Expected put of '%v' to be '%v'", key, !present[key])
+					}
+					present[key] = true
+				} else {
+					if _, ok := m.Delete(key); ok != present[key] {
+						t.Errorf("Expected delete of '%v' to be '%v'", key, present[key])
+					}
+					delete(present, key)
+				}
+
+				if _, ok := m.Get(key); ok != present[key] {
+					t.Errorf("Expected get of '%v' to be '%v'", key, present[key])
+				}
+			}
+
+			// leave only the even keys of the goroutine
+			for key := g; key < keysPerGoroutine*goroutines; key += goroutines {
+				if key%2 == 0 {
+					m.Put(key, g)
+				} else {
+					m.Delete(key)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	keys := rangeKeys(m, 0, keysPerGoroutine*goroutines)
+	if len(keys) != keysPerGoroutine*goroutines/2 || m.Len() != len(keys) {
+		t.Fatalf("Expected '%v' keys. Got '%v' with len '%v'", keysPerGoroutine*goroutines/2, len(keys), m.Len())
+	}
+	for i, key := range keys {
+		if key != 2*i {
+			t.Fatalf("Expected key '%v' to be '%v'", key, 2*i)
+		}
+	}
+}
+
+func TestConcurrentSkipListMapRangeWhileMutating(t *testing.T) {
+	const keys = 2000
+	m := gostrutures.NewConcurrentSkipListMap()
+	// the even keys are never changed, the odd ones are put and deleted while ranging
+	for key := 0; key < keys; key += 2 {
+		m.Put(key, key)
+	}
+
+	var done atomic.Bool
+	var wg sync.WaitGroup
+	defer func() {
+		done.Store(true)
+		wg.Wait()
+	}()
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(int64(g)))
+			for !done.Load() {
+				key := 2*r.Intn(keys/2) + 1
+				if r.Intn(2) == 0 {
+					m.Put(key, key)
+				} else {
+					m.Delete(key)
+				}
+			}
+		}(g)
+	}
+
+	for i := 0; i < 50; i++ {
+		last, stable := -1, 0
+		m.Range(0, keys, func(key int, value gostrutures.Item) bool {
+			if key <= last {
+				t.Fatalf("Expected range keys in increasing order. Got '%v' after '%v'", key, last)
+			}
+			if value != key {
+				t.Fatalf("Expected value of '%v' to be '%v'. Got '%v'", key, key, value)
+			}
+			if key%2 == 0 {
+				stable++
+			}
+			last = key
+			return true
+		})
+
+		if stable != keys/2 {
+			t.Fatalf("Expected range to visit every unchanged key '%v'. Got '%v'", keys/2, stable)
+		}
+	}
+}
+
+func TestConcurrentSkipListMapRangeIsLinearizable(t *testing.T) {
+	const keys = 200
+	m := gostrutures.NewConcurrentSkipListMap()
+
+	// the writer puts the keys in increasing order and then deletes them in the same order,
+	// so at any point in time the map holds the keys from 0 to i or from i to keys-1
+	var done atomic.Bool
+	var rounds atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for !done.Load() {
+			for key := 0; key < keys; key++ {
+				m.Put(key, key)
+			}
+			for key := 0; key < keys; key++ {
+				m.Delete(key)
+			}
+			rounds.Add(1)
+		}
+	}()
+	defer func() {
+		done.Store(true)
+		wg.Wait()
+	}()
+
+	for rounds.Load() < 200 {
+		result := rangeKeys(m, 0, keys)
+		if len(result) == 0 {
+			continue
+		}
+
+		first, last := result[0], result[len(result)-1]
+		if last-first+1 != len(result) || (first != 0 && last != keys-1) {
+			t.Fatalf("Expected range to be a snapshot of the map. Got '%v'", result)
+		}
+	}
+}
+
+func TestConcurrentSkipListMapLenIsNeverNegative(t *testing.T) {
+	m := gostrutures.NewConcurrentSkipListMap()
+
+	var done atomic.Bool
+	var rounds atomic.Int64
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			// half of the goroutines put the keys and the other half delete them
+			for !done.Load() {
+				for key := 0; key < 8; key++ {
+					if g%2 == 0 {
+						m.Put(key, key)
+					} else {
+						m.Delete(key)
+					}
+				}
+				rounds.Add(1)
+			}
+		}(g)
+	}
+
+	for rounds.Load() < 20000 {
+		if n := m.Len(); n < 0 || n > 8 {
+			done.Store(true)
+			wg.Wait()
+			t.Fatalf("Expected len to be between '0' and '8'. Got '%v'", n)
+		}
+	}
+	done.Store(true)
+	wg.Wait()
+}
+
+// lockedSkipList is the baseline of a map behind a single lock.
+type lockedSkipList struct {
+	mu   sync.RWMutex
+	list *gostrutures.SkipList
+}
+
+func benchmarkOrderedMap(b *testing.B, put func(int), get func(int) bool) {
+	for key := 0; key < 1<<14; key += 2 {
+		put(key)
+	}
+
+	b.RunParallel(func(pb *testing.PB) {
+		r := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			key := r.Intn(1 << 14)
+			if r.Intn(10) == 0 {
+				put(key)
+			} else {
+				get(key)
+			}
+		}
+	})
+}
+
+func BenchmarkConcurrentSkipListMap(b *testing.B) {
+	m := gostrutures.NewConcurrentSkipListMap()
+	benchmarkOrderedMap(b,
+		func(key int) { m.Put(key, key) },
+		func(key int) bool { return m.Has(key) },
+	)
+}
+
+func BenchmarkLockedSkipList(b *testing.B) {
+	m := &lockedSkipList{list: gostrutures.NewSkipList(nil)}
+	benchmarkOrderedMap(b,
+		func(key int) {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			m.list.Insert(key)
+		},
+		func(key int) bool {
+			m.mu.RLock()
+			defer m.mu.RUnlock()
+			return m.list.Has(key)
+		},
+	)
+}
@@ -0,0 +1,170 @@
+package gostrutures
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time of a DelayQueue, so it can be replaced in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// SystemClock is the Clock backed by the time package.
+var SystemClock Clock = systemClock{}
+
+// DelayQueue is a queue safe for concurrent use by multiple goroutines, where every element
+// (Item) becomes available only once its ready time has passed. The elements are taken in
+// order of ready time, and in FIFO order when it's the same.
+type DelayQueue struct {
+	mu      sync.Mutex
+	clock   Clock
+	entries delayEntries
+	// pushed is closed and replaced to wake up the takers when an element is pushed.
+	pushed chan struct{}
+}
+
+type delayEntry struct {
+	item    Item
+	readyAt time.Time
+	seq     uint64
+}
+
+// delayEntries implements heap.Interface ordering the entries by ready time with time.Time
+// comparisons, as any time can be pushed, even out of the range of UnixNano. Equal times are
+// ordered by push sequence.
+type delayEntries struct {
+	entries []delayEntry
+	seq     uint64
+}
+
+func (e delayEntries) Len() int {
+	return len(e.entries)
+}
+
+func (e delayEntries) Less(i, j int) bool {
+	a, b := e.entries[i], e.entries[j]
+	if a.readyAt.Equal(b.readyAt) {
+		return a.seq < b.seq
+	}
+	return a.readyAt.Before(b.readyAt)
+}
+
+func (e delayEntries) Swap(i, j int) {
+	e.entries[i], e.entries[j] = e.entries[j], e.entries[i]
+}
+
+func (e *delayEntries) Push(x interface{}) {
+	e.entries = append(e.entries, x.(delayEntry))
+}
+
+func (e *delayEntries) Pop() interface{} {
+	last := len(e.entries) - 1
+	entry := e.entries[last]
+	// release the reference so the item can be garbage collected
+	e.entries[last] = delayEntry{}
+	e.entries = e.entries[:last]
+	return entry
+}
+
+// NewDelayQueue build an empty DelayQueue that measures the time with clock. If clock is
+// nil SystemClock is used.
+func NewDelayQueue(clock Clock) *DelayQueue {
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	return &DelayQueue{
+		clock:  clock,
+		pushed: make(chan struct{}),
+	}
+}
+
+// Push adds an element (Item) that will be available to take once readyAt has passed.
+func (q *DelayQueue) Push(value Item, readyAt time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	heap.Push(&q.entries, delayEntry{value, readyAt, q.entries.seq})
+	q.entries.seq++
+	q.pushed = broadcast(q.pushed)
+}
+
+// Take retrieves and removes the element with the earliest ready time, waiting until it's ready.
+func (q *DelayQueue) Take() Item {
+	item, _ := q.TakeCtx(context.Background())
+	return item
+}
+
+// TakeCtx retrieves and removes the element with the earliest ready time, waiting until it's
+// ready or the context is done. Return the context error if it's done before an element is ready.
+func (q *DelayQueue) TakeCtx(ctx context.Context) (Item, error) {
+	for {
+		q.mu.Lock()
+		item, wait, ok := q.poll()
+		pushed := q.pushed
+		q.mu.Unlock()
+		if ok {
+			return item, nil
+		}
+
+		// wait for the earliest element to be ready or for a new one that could be earlier
+		var ready <-chan time.Time
+		if wait > 0 {
+			ready = q.clock.After(wait)
+		}
+		select {
+		case <-ready:
+		case <-pushed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Poll retrieves and removes the element with the earliest ready time without waiting.
+// Return false if no element is ready.
+func (q *DelayQueue) Poll() (Item, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	item, _, ok := q.poll()
+	return item, ok
+}
+
+// Len returns the number of Items in the queue, ready or not.
+func (q *DelayQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.entries.entries)
+}
+
+// poll pops the earliest element if it's ready, otherwise it returns how long until it's
+// ready, or zero if the queue is empty.
+func (q *DelayQueue) poll() (Item, time.Duration, bool) {
+	if len(q.entries.entries) == 0 {
+		return nil, 0, false
+	}
+
+	entry := q.entries.entries[0]
+	if wait := entry.readyAt.Sub(q.clock.Now()); wait > 0 {
+		return nil, wait, false
+	}
+	heap.Pop(&q.entries)
+	return entry.item, 0, true
+}
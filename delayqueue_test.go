@@ -0,0 +1,195 @@
+package gostrutures_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+// fakeClock is a Clock whose time only moves forward when advanced.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	c.waiters = append(c.waiters, fakeWaiter{c.now.Add(d), ch})
+	return ch
+}
+
+// Advance moves the time forward and fires the waiters whose deadline passed.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.deadline.After(c.now) {
+			pending = append(pending, w)
+			continue
+		}
+		w.ch <- c.now
+	}
+	c.waiters = pending
+}
+
+// blockUntilWaiters waits until n goroutines are waiting on the clock.
+func (c *fakeClock) blockUntilWaiters(n int) {
+	for {
+		c.mu.Lock()
+		waiting := len(c.waiters)
+		c.mu.Unlock()
+		if waiting >= n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDelayQueuePoll(t *testing.T) {
+	clock := newFakeClock()
+	q := gostrutures.NewDelayQueue(clock)
+	q.Push("later", clock.Now().Add(2*time.Second))
+	q.Push("first", clock.Now().Add(time.Second))
+	q.Push("second", clock.Now().Add(time.Second))
+	q.Push("past", clock.Now().Add(-time.Second))
+
+	tt := []struct {
+		name     string
+		advance  time.Duration
+		expected []gostrutures.Item
+	}{
+		{"only the past item is ready", 0, []gostrutures.Item{"past"}},
+		{"not ready yet", 999 * time.Millisecond, nil},
+		{"same ready time in FIFO order", time.Millisecond, []gostrutures.Item{"first", "second"}},
+		{"last item", time.Second, []gostrutures.Item{"later"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			clock.Advance(tc.advance)
+			for _, expected := range tc.expected {
+				if result, ok := q.Poll(); result != expected || !ok {
+					t.Errorf("Expected poll to be '%v, true'. Got '%v, %v'", expected, result, ok)
+				}
+			}
+
+			if result, ok := q.Poll(); ok {
+				t.Errorf("Expected poll to be false. Got '%v'", result)
+			}
+		})
+	}
+
+	if q.Len() != 0 {
+		t.Errorf("Expected queue len to be '%v'. Got '%v'", 0, q.Len())
+	}
+}
+
+func TestDelayQueueTakeWaitsUntilReady(t *testing.T) {
+	clock := newFakeClock()
+	q := gostrutures.NewDelayQueue(clock)
+	q.Push(1, clock.Now().Add(time.Minute))
+
+	result := make(chan gostrutures.Item)
+	go func() {
+		result <- q.Take()
+	}()
+
+	clock.blockUntilWaiters(1)
+	clock.Advance(30 * time.Second)
+	select {
+	case item := <-result:
+		t.Fatalf("Expected take to wait. Got '%v'", item)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	clock.Advance(30 * time.Second)
+	if item := <-result; item != 1 {
+		t.Errorf("Expected take to be '%v'. Got '%v'", 1, item)
+	}
+}
+
+func TestDelayQueueTakeWakesUpWithEarlierPush(t *testing.T) {
+	clock := newFakeClock()
+	q := gostrutures.NewDelayQueue(clock)
+
+	result := make(chan gostrutures.Item)
+	go func() {
+		result <- q.Take()
+	}()
+
+	q.Push("late", clock.Now().Add(time.Hour))
+	clock.blockUntilWaiters(1)
+	q.Push("early", clock.Now().Add(time.Second))
+	clock.blockUntilWaiters(2)
+
+	clock.Advance(time.Second)
+	if item := <-result; item != "early" {
+		t.Errorf("Expected take to be '%v'. Got '%v'", "early", item)
+	}
+}
+
+func TestDelayQueueTakeCtx(t *testing.T) {
+	q := gostrutures.NewDelayQueue(newFakeClock())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if item, err := q.TakeCtx(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected take error to be '%v'. Got '%v, %v'", context.DeadlineExceeded, item, err)
+	}
+}
+
+func TestDelayQueueSystemClock(t *testing.T) {
+	q := gostrutures.NewDelayQueue(nil)
+	q.Push(1, time.Now().Add(5*time.Millisecond))
+
+	if item := q.Take(); item != 1 {
+		t.Errorf("Expected take to be '%v'. Got '%v'", 1, item)
+	}
+}
+
+func TestDelayQueueTimesOutOfUnixNanoRange(t *testing.T) {
+	// UnixNano is undefined after 2262
+	clock := &fakeClock{now: time.Date(2250, 1, 1, 0, 0, 0, 0, time.UTC)}
+	q := gostrutures.NewDelayQueue(clock)
+	q.Push("far", clock.Now().AddDate(50, 0, 0))
+	q.Push("now", clock.Now())
+	q.Push("zero", time.Time{})
+
+	for _, expected := range []string{"zero", "now"} {
+		if result, ok := q.Poll(); result != expected || !ok {
+			t.Errorf("Expected poll to be '%v, true'. Got '%v, %v'", expected, result, ok)
+		}
+	}
+
+	if result, ok := q.Poll(); ok {
+		t.Errorf("Expected the far future item to not be ready. Got '%v'", result)
+	}
+
+	clock.Advance(51 * 365 * 24 * time.Hour)
+	if result, ok := q.Poll(); result != "far" || !ok {
+		t.Errorf("Expected poll to be 'far, true'. Got '%v, %v'", result, ok)
+	}
+}
@@ -0,0 +1,61 @@
+package gostrutures
+
+// Deque is a double-ended queue backed by a growable circular buffer, where elements
+// (Item) can be added and removed at both ends in amortized O(1).
+//
+// Read/Write operations are not safe for concurrent mutation by multiple goroutines.
+type Deque struct {
+	ring ring[Item]
+}
+
+// NewDeque build an empty Deque.
+func NewDeque() *Deque {
+	return &Deque{}
+}
+
+// PushFront adds an element (Item) to the front of the deque.
+func (d *Deque) PushFront(value Item) {
+	d.ring.pushFront(value)
+}
+
+// PushBack adds an element (Item) to the back of the deque.
+func (d *Deque) PushBack(value Item) {
+	d.ring.push(value)
+}
+
+// PopFront retrieves and removes the front of the deque. Return false if the deque is empty.
+func (d *Deque) PopFront() (Item, bool) {
+	return d.ring.pop()
+}
+
+// PopBack retrieves and removes the back of the deque. Return false if the deque is empty.
+func (d *Deque) PopBack() (Item, bool) {
+	return d.ring.popBack()
+}
+
+// PeekFront returns but does not remove, the front of the deque. Return false if the deque is empty.
+func (d *Deque) PeekFront() (Item, bool) {
+	return d.ring.peek()
+}
+
+// PeekBack returns but does not remove, the back of the deque. Return false if the deque is empty.
+func (d *Deque) PeekBack() (Item, bool) {
+	return d.ring.peekBack()
+}
+
+// At returns the i-th element (Item) counting from the front. It panics if i is out of range.
+func (d *Deque) At(i int) Item {
+	return d.ring.at(i)
+}
+
+// Len returns the number of Items in the deque
+func (d *Deque) Len() int {
+	return d.ring.size
+}
+
+// Each visits all the elements from the front to the back
+func (d *Deque) Each(f func(Item)) {
+	for i := 0; i < d.ring.size; i++ {
+		f(d.ring.at(i))
+	}
+}
@@ -0,0 +1,161 @@
+package gostrutures_test
+
+import (
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func dequeItems(d *gostrutures.Deque) []gostrutures.Item {
+	var items []gostrutures.Item
+	d.Each(func(item gostrutures.Item) {
+		items = append(items, item)
+	})
+	return items
+}
+
+func TestNewDeque(t *testing.T) {
+	d := gostrutures.NewDeque()
+	if d == nil {
+		t.Error("Expected deque to be not nil")
+	}
+}
+
+func TestDequePush(t *testing.T) {
+	tt := []struct {
+		name     string
+		push     func(d *gostrutures.Deque)
+		expected []int
+	}{
+		{"empty deque", func(*gostrutures.Deque) {}, []int{}},
+		{"push back", func(d *gostrutures.Deque) {
+			for i := 1; i <= 10; i++ {
+				d.PushBack(i)
+			}
+		}, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{"push front", func(d *gostrutures.Deque) {
+			for i := 1; i <= 10; i++ {
+				d.PushFront(i)
+			}
+		}, []int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}},
+		{"push both ends", func(d *gostrutures.Deque) {
+			for i := 1; i <= 10; i++ {
+				if i%2 == 0 {
+					d.PushFront(i)
+				} else {
+					d.PushBack(i)
+				}
+			}
+		}, []int{10, 8, 6, 4, 2, 1, 3, 5, 7, 9}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			d := new(gostrutures.Deque)
+			tc.push(d)
+
+			if d.Len() != len(tc.expected) {
+				t.Fatalf("Expected deque len to be '%v'. Got '%v'", len(tc.expected), d.Len())
+			}
+
+			items := dequeItems(d)
+			for i := range tc.expected {
+				if d.At(i) != tc.expected[i] || items[i] != tc.expected[i] {
+					t.Fatalf("Expected deque items to be '%v'. Got '%v'", tc.expected, items)
+				}
+			}
+		})
+	}
+}
+
+func TestDequePop(t *testing.T) {
+	d := gostrutures.NewDeque()
+	for _, v := range []int{1, 2, 3, 4} {
+		d.PushBack(v)
+	}
+
+	tt := []struct {
+		name     string
+		peek     func() (gostrutures.Item, bool)
+		pop      func() (gostrutures.Item, bool)
+		expected int
+	}{
+		{"front", d.PeekFront, d.PopFront, 1},
+		{"back", d.PeekBack, d.PopBack, 4},
+		{"back again", d.PeekBack, d.PopBack, 3},
+		{"last element", d.PeekFront, d.PopFront, 2},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if result, ok := tc.peek(); result != tc.expected || !ok {
+				t.Errorf("Expected peek to be '%v, true'. Got '%v, %v'", tc.expected, result, ok)
+			}
+
+			if result, ok := tc.pop(); result != tc.expected || !ok {
+				t.Errorf("Expected pop to be '%v, true'. Got '%v, %v'", tc.expected, result, ok)
+			}
+		})
+	}
+
+	if d.Len() != 0 {
+		t.Errorf("Expected deque len to be '%v'. Got '%v'", 0, d.Len())
+	}
+}
+
+func TestDequeEmpty(t *testing.T) {
+	d := gostrutures.NewDeque()
+	for _, f := range []func() (gostrutures.Item, bool){d.PeekFront, d.PeekBack, d.PopFront, d.PopBack} {
+		if result, ok := f(); result != nil || ok {
+			t.Errorf("Expected result to be 'nil, false'. Got '%v, %v'", result, ok)
+		}
+	}
+}
+
+func TestDequeAtOutOfRange(t *testing.T) {
+	d := gostrutures.NewDeque()
+	d.PushBack(1)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected At out of range to panic")
+		}
+	}()
+	d.At(1)
+}
+
+// slidingWindowMax returns the maximum of every window of size k keeping in the deque
+// the indexes of the candidates in decreasing order of value.
+func slidingWindowMax(values []int, k int) []int {
+	var result []int
+	window := gostrutures.NewDeque()
+	for i, v := range values {
+		if front, ok := window.PeekFront(); ok && front.(int) <= i-k {
+			window.PopFront()
+		}
+		for back, ok := window.PeekBack(); ok && values[back.(int)] <= v; back, ok = window.PeekBack() {
+			window.PopBack()
+		}
+		window.PushBack(i)
+		if i >= k-1 {
+			front, _ := window.PeekFront()
+			result = append(result, values[front.(int)])
+		}
+	}
+	return result
+}
+
+func TestDequeSlidingWindow(t *testing.T) {
+	values := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	expected := []int{3, 3, 5, 5, 6, 7}
+
+	result := slidingWindowMax(values, 3)
+	if len(result) != len(expected) {
+		t.Fatalf("Expected sliding window max to be '%v'. Got '%v'", expected, result)
+	}
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Fatalf("Expected sliding window max to be '%v'. Got '%v'", expected, result)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package gostrutures
+
+// FibonacciHeap is a MergeableHeap implemented as a Fibonacci heap. Insert, FindMin, Meld and
+// DecreaseKey take O(1) amortized, while DeleteMin takes O(log n) amortized.
+type FibonacciHeap struct {
+	min   *fibonacciNode
+	size  int
+	owner *heapOwner
+}
+
+type fibonacciNode struct {
+	item Item
+	key  int
+	// left and right link the node into a circular list of siblings.
+	parent, child, left, right *fibonacciNode
+	degree                     int
+	// marked is set when the node lost a child since it became child of its parent.
+	marked bool
+	// owner identifies the heap the node belongs to, or nil once deleted.
+	owner *heapOwner
+}
+
+func (n *fibonacciNode) Item() Item {
+	return n.item
+}
+
+func (n *fibonacciNode) Key() int {
+	return n.key
+}
+
+// NewFibonacciHeap build an empty FibonacciHeap.
+func NewFibonacciHeap() *FibonacciHeap {
+	return &FibonacciHeap{}
+}
+
+// Insert adds an element (Item) with the given key and returns its node.
+func (h *FibonacciHeap) Insert(value Item, key int) HeapNode {
+	if h.owner == nil {
+		h.owner = &heapOwner{}
+	}
+	node := &fibonacciNode{item: value, key: key, owner: h.owner}
+	node.left, node.right = node, node
+	h.addRoot(node)
+	h.size++
+	return node
+}
+
+// FindMin returns but does not remove, the element with the minimal key and its key.
+// Return false if the heap is empty.
+func (h *FibonacciHeap) FindMin() (Item, int, bool) {
+	if h.min == nil {
+		return nil, 0, false
+	}
+	return h.min.item, h.min.key, true
+}
+
+// DeleteMin retrieves and removes the element with the minimal key and its key.
+// Return false if the heap is empty.
+func (h *FibonacciHeap) DeleteMin() (Item, int, bool) {
+	min := h.min
+	if min == nil {
+		return nil, 0, false
+	}
+
+	// move the children of the min node to the root list
+	if min.child != nil {
+		child := min.child
+		for {
+			child.parent = nil
+			child = child.right
+			if child == min.child {
+				break
+			}
+		}
+		spliceFibonacci(min, min.child)
+	}
+
+	if min.right == min {
+		h.min = nil
+	} else {
+		min.left.right, min.right.left = min.right, min.left
+		h.min = min.right
+		h.consolidate()
+	}
+
+	h.size--
+	min.child, min.left, min.right, min.owner = nil, nil, nil, nil
+	return min.item, min.key, true
+}
+
+// DecreaseKey lowers the key of the node. Return false if the node is not in the heap
+// or the key is greater than the current one.
+func (h *FibonacciHeap) DecreaseKey(node HeapNode, key int) bool {
+	n, ok := node.(*fibonacciNode)
+	if !ok || n.owner == nil || n.owner.resolve() != h.owner || key > n.key {
+		return false
+	}
+
+	n.key = key
+	if parent := n.parent; parent != nil && n.key < parent.key {
+		h.cut(n, parent)
+		h.cascadingCut(parent)
+	}
+	if n.key < h.min.key {
+		h.min = n
+	}
+	return true
+}
+
+// Meld moves all the elements of other into the heap, leaving other empty. It panics
+// if other is not a *FibonacciHeap.
+func (h *FibonacciHeap) Meld(other MergeableHeap) {
+	o := other.(*FibonacciHeap)
+	if o == h || o.min == nil {
+		return
+	}
+
+	h.owner = o.owner.forwardTo(h.owner)
+	if h.min == nil {
+		h.min = o.min
+	} else {
+		spliceFibonacci(h.min, o.min)
+		if o.min.key < h.min.key {
+			h.min = o.min
+		}
+	}
+	h.size += o.size
+	o.min, o.size, o.owner = nil, 0, nil
+}
+
+// Len returns the number of Items in the heap.
+func (h *FibonacciHeap) Len() int {
+	return h.size
+}
+
+// addRoot adds a node with no siblings to the root list.
+func (h *FibonacciHeap) addRoot(node *fibonacciNode) {
+	if h.min == nil {
+		h.min = node
+		return
+	}
+
+	spliceFibonacci(h.min, node)
+	if node.key < h.min.key {
+		h.min = node
+	}
+}
+
+// consolidate links the roots with the same degree until every root has a different degree.
+func (h *FibonacciHeap) consolidate() {
+	var roots []*fibonacciNode
+	for node := h.min; ; {
+		roots = append(roots, node)
+		node = node.right
+		if node == h.min {
+			break
+		}
+	}
+
+	var byDegree []*fibonacciNode
+	for _, node := range roots {
+		node.left, node.right = node, node
+		for node.degree < len(byDegree) && byDegree[node.degree] != nil {
+			other := byDegree[node.degree]
+			byDegree[node.degree] = nil
+			if other.key < node.key {
+				node, other = other, node
+			}
+			linkFibonacci(other, node)
+		}
+		for len(byDegree) <= node.degree {
+			byDegree = append(byDegree, nil)
+		}
+		byDegree[node.degree] = node
+	}
+
+	h.min = nil
+	for _, node := range byDegree {
+		if node != nil {
+			h.addRoot(node)
+		}
+	}
+}
+
+// cut moves the node from the children of parent to the root list.
+func (h *FibonacciHeap) cut(node, parent *fibonacciNode) {
+	if node.right == node {
+		parent.child = nil
+	} else {
+		node.left.right, node.right.left = node.right, node.left
+		if parent.child == node {
+			parent.child = node.right
+		}
+	}
+	parent.degree--
+
+	node.left, node.right = node, node
+	node.parent = nil
+	node.marked = false
+	h.addRoot(node)
+}
+
+// cascadingCut cuts the node from its parent if it already lost a child, going up the tree.
+func (h *FibonacciHeap) cascadingCut(node *fibonacciNode) {
+	for parent := node.parent; parent != nil; node, parent = parent, parent.parent {
+		if !node.marked {
+			node.marked = true
+			return
+		}
+		h.cut(node, parent)
+	}
+}
+
+// linkFibonacci makes the root child a child of the root parent.
+func linkFibonacci(child, parent *fibonacciNode) {
+	child.left, child.right = child, child
+	child.parent = parent
+	child.marked = false
+	if parent.child == nil {
+		parent.child = child
+	} else {
+		spliceFibonacci(parent.child, child)
+	}
+	parent.degree++
+}
+
+// spliceFibonacci joins two circular lists of siblings into one.
+func spliceFibonacci(a, b *fibonacciNode) {
+	aRight, bLeft := a.right, b.left
+	a.right, b.left = b, a
+	bLeft.right, aRight.left = aRight, bLeft
+}
@@ -0,0 +1,189 @@
+package gostrutures
+
+import "slices"
+
+// DefaultIndexedPriorityQueueMaxID is the greatest id an IndexedPriorityQueue accepts when it
+// was built with a lower capacity, as the queue allocates room for every id up to the greatest one.
+const DefaultIndexedPriorityQueueMaxID = 1<<24 - 1
+
+// IndexedPriorityQueue is a min priority queue of integer ids implemented with a d-ary heap,
+// where every id has an integer key and can be found in O(1). It allows to decrease or
+// increase the key of an id in O(log n), as needed by graph algorithms like Dijkstra or Prim.
+//
+// A higher arity makes the heap shallower, so decreasing keys and inserting are cheaper
+// while popping compares more children per level.
+//
+// Read/Write operations are not safe for concurrent mutation by multiple goroutines.
+type IndexedPriorityQueue struct {
+	arity int
+	// heap holds the ids ordered as a d-ary heap by their keys.
+	heap []int
+	// pos holds for every id its index into heap, or -1 if the id is not in the queue.
+	pos  []int
+	keys []int
+	// maxID is the greatest id accepted.
+	maxID int
+}
+
+// NewIndexedPriorityQueue build an empty IndexedPriorityQueue with room for the ids from 0
+// to capacity-1 before growing, over a heap with the given arity. The queue accepts the ids
+// up to capacity-1 or DefaultIndexedPriorityQueueMaxID, whichever is greater. It panics if
+// arity is lower than 2.
+func NewIndexedPriorityQueue(capacity, arity int) *IndexedPriorityQueue {
+	if arity < 2 {
+		panic("gostrutures: IndexedPriorityQueue arity must be at least 2")
+	}
+
+	q := &IndexedPriorityQueue{
+		arity: arity,
+		heap:  make([]int, 0, capacity),
+		maxID: max(capacity-1, DefaultIndexedPriorityQueueMaxID),
+	}
+	q.grow(capacity)
+	return q
+}
+
+// Insert adds the id with the given key. Return false if the id is negative, greater than
+// the max id accepted or it's already in the queue.
+func (q *IndexedPriorityQueue) Insert(id, key int) bool {
+	if id < 0 || id > q.maxID || q.Contains(id) {
+		return false
+	}
+
+	q.grow(id + 1)
+	q.keys[id] = key
+	q.pos[id] = len(q.heap)
+	q.heap = append(q.heap, id)
+	q.up(len(q.heap) - 1)
+	return true
+}
+
+// Contains returns true if the id is in the queue.
+func (q *IndexedPriorityQueue) Contains(id int) bool {
+	return id >= 0 && id < len(q.pos) && q.pos[id] >= 0
+}
+
+// KeyOf returns the key of the id. Return false if the id is not in the queue.
+func (q *IndexedPriorityQueue) KeyOf(id int) (int, bool) {
+	if !q.Contains(id) {
+		return 0, false
+	}
+	return q.keys[id], true
+}
+
+// DecreaseKey lowers the key of the id. Return false if the id is not in the queue or the
+// key is greater than the current one.
+func (q *IndexedPriorityQueue) DecreaseKey(id, key int) bool {
+	if !q.Contains(id) || key > q.keys[id] {
+		return false
+	}
+
+	q.keys[id] = key
+	q.up(q.pos[id])
+	return true
+}
+
+// IncreaseKey raises the key of the id. Return false if the id is not in the queue or the
+// key is lower than the current one.
+func (q *IndexedPriorityQueue) IncreaseKey(id, key int) bool {
+	if !q.Contains(id) || key < q.keys[id] {
+		return false
+	}
+
+	q.keys[id] = key
+	q.down(q.pos[id])
+	return true
+}
+
+// Peek returns but does not remove, the id with the minimal key and its key. Return false if the queue is empty.
+func (q *IndexedPriorityQueue) Peek() (int, int, bool) {
+	if len(q.heap) == 0 {
+		return -1, 0, false
+	}
+	return q.heap[0], q.keys[q.heap[0]], true
+}
+
+// Pop retrieves and removes the id with the minimal key and its key. Return false if the queue is empty.
+func (q *IndexedPriorityQueue) Pop() (int, int, bool) {
+	id, key, ok := q.Peek()
+	if ok {
+		q.removeAt(0)
+	}
+	return id, key, ok
+}
+
+// Remove removes the id from the queue. Return false if the id is not in the queue.
+func (q *IndexedPriorityQueue) Remove(id int) bool {
+	if !q.Contains(id) {
+		return false
+	}
+
+	q.removeAt(q.pos[id])
+	return true
+}
+
+// Len returns the number of ids in the queue.
+func (q *IndexedPriorityQueue) Len() int {
+	return len(q.heap)
+}
+
+// grow makes room for the ids lower than size.
+func (q *IndexedPriorityQueue) grow(size int) {
+	n := len(q.pos)
+	if size <= n {
+		return
+	}
+
+	q.pos = slices.Grow(q.pos, size-n)[:size]
+	q.keys = slices.Grow(q.keys, size-n)[:size]
+	for i := n; i < size; i++ {
+		q.pos[i], q.keys[i] = -1, 0
+	}
+}
+
+func (q *IndexedPriorityQueue) removeAt(i int) {
+	last := len(q.heap) - 1
+	id := q.heap[i]
+	q.swap(i, last)
+	q.heap = q.heap[:last]
+	q.pos[id] = -1
+
+	if i < last {
+		q.down(i)
+		q.up(i)
+	}
+}
+
+func (q *IndexedPriorityQueue) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / q.arity
+		if q.keys[q.heap[parent]] <= q.keys[q.heap[i]] {
+			return
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+func (q *IndexedPriorityQueue) down(i int) {
+	for {
+		smallest := i
+		first := i*q.arity + 1
+		for child := first; child < first+q.arity && child < len(q.heap); child++ {
+			if q.keys[q.heap[child]] < q.keys[q.heap[smallest]] {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		q.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (q *IndexedPriorityQueue) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.pos[q.heap[i]] = i
+	q.pos[q.heap[j]] = j
+}
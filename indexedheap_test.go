@@ -0,0 +1,204 @@
+package gostrutures_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func TestNewIndexedPriorityQueuePanicsWithLowArity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected NewIndexedPriorityQueue with arity 1 to panic")
+		}
+	}()
+	gostrutures.NewIndexedPriorityQueue(0, 1)
+}
+
+func TestIndexedPriorityQueuePop(t *testing.T) {
+	for _, arity := range []int{2, 3, 4, 8} {
+		t.Run(fmt.Sprintf("arity %d", arity), func(t *testing.T) {
+			r := rand.New(rand.NewSource(int64(arity)))
+			q := gostrutures.NewIndexedPriorityQueue(10, arity)
+			keys := make([]int, 200)
+			for id := range keys {
+				keys[id] = r.Intn(1000)
+				if !q.Insert(id, keys[id]) {
+					t.Fatalf("Expected insert of '%v' to be true", id)
+				}
+			}
+
+			if q.Len() != len(keys) {
+				t.Errorf("Expected queue len to be '%v'. Got '%v'", len(keys), q.Len())
+			}
+
+			sorted := append([]int{}, keys...)
+			sort.Ints(sorted)
+			for _, expected := range sorted {
+				id, key, ok := q.Pop()
+				if !ok || key != expected || keys[id] != key {
+					t.Fatalf("Expected pop key to be '%v'. Got id '%v' with key '%v, %v'", expected, id, key, ok)
+				}
+
+				if q.Contains(id) {
+					t.Fatalf("Expected popped id '%v' to not be contained", id)
+				}
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueueInsert(t *testing.T) {
+	q := gostrutures.NewIndexedPriorityQueue(0, 2)
+
+	tt := []struct {
+		name     string
+		id       int
+		expected bool
+	}{
+		{"first id", 5, true},
+		{"duplicated id", 5, false},
+		{"negative id", -1, false},
+		{"id beyond capacity", 100, true},
+		{"id beyond the max id", gostrutures.DefaultIndexedPriorityQueueMaxID + 1, false},
+		{"huge id", 1 << 40, false},
+		{"max int id", math.MaxInt, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := q.Insert(tc.id, tc.id); result != tc.expected {
+				t.Errorf("Expected insert to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueueChangeKey(t *testing.T) {
+	q := gostrutures.NewIndexedPriorityQueue(4, 4)
+	for id, key := range []int{10, 20, 30, 40} {
+		q.Insert(id, key)
+	}
+
+	tt := []struct {
+		name     string
+		change   func(id, key int) bool
+		id, key  int
+		expected bool
+		peekID   int
+	}{
+		{"decrease key to the top", q.DecreaseKey, 3, 5, true, 3},
+		{"decrease to a greater key", q.DecreaseKey, 1, 25, false, 3},
+		{"increase key from the top", q.IncreaseKey, 3, 50, true, 0},
+		{"increase to a lower key", q.IncreaseKey, 2, 1, false, 0},
+		{"missing id", q.DecreaseKey, 7, 1, false, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := tc.change(tc.id, tc.key); result != tc.expected {
+				t.Errorf("Expected change key to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if key, ok := q.KeyOf(tc.id); tc.expected && (key != tc.key || !ok) {
+				t.Errorf("Expected key of '%v' to be '%v'. Got '%v'", tc.id, tc.key, key)
+			}
+
+			if id, _, _ := q.Peek(); id != tc.peekID {
+				t.Errorf("Expected peek id to be '%v'. Got '%v'", tc.peekID, id)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueueRemove(t *testing.T) {
+	q := gostrutures.NewIndexedPriorityQueue(5, 2)
+	for id, key := range []int{3, 1, 4, 1, 5} {
+		q.Insert(id, key)
+	}
+
+	if !q.Remove(1) || q.Remove(1) {
+		t.Fatal("Expected only the first remove to be true")
+	}
+
+	var result []int
+	for id, _, ok := q.Pop(); ok; id, _, ok = q.Pop() {
+		result = append(result, id)
+	}
+
+	expected := []int{3, 0, 2, 4}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Expected pop order to be '%v'. Got '%v'", expected, result)
+	}
+
+	if _, _, ok := q.Peek(); ok {
+		t.Error("Expected peek from an empty queue to be false")
+	}
+}
+
+// dijkstra returns the distances from the node 0 over a graph given as adjacency lists of
+// [node, weight] edges, or -1 for the unreachable nodes.
+func dijkstra(graph [][][2]int, arity int) []int {
+	dist := make([]int, len(graph))
+	for i := range dist {
+		dist[i] = -1
+	}
+
+	q := gostrutures.NewIndexedPriorityQueue(len(graph), arity)
+	q.Insert(0, 0)
+	for node, d, ok := q.Pop(); ok; node, d, ok = q.Pop() {
+		dist[node] = d
+		for _, edge := range graph[node] {
+			next, nd := edge[0], d+edge[1]
+			if dist[next] >= 0 {
+				continue
+			}
+			if !q.Insert(next, nd) {
+				q.DecreaseKey(next, nd)
+			}
+		}
+	}
+	return dist
+}
+
+func TestIndexedPriorityQueueDijkstra(t *testing.T) {
+	graph := [][][2]int{
+		{{1, 4}, {2, 1}},
+		{{3, 1}},
+		{{1, 2}, {3, 5}},
+		{},
+		{{0, 1}},
+	}
+	expected := []int{0, 3, 1, 4, -1}
+
+	result := dijkstra(graph, 2)
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Expected distances to be '%v'. Got '%v'", expected, result)
+	}
+}
+
+func randGraph(nodes, edgesPerNode int) [][][2]int {
+	r := rand.New(rand.NewSource(1))
+	graph := make([][][2]int, nodes)
+	for node := range graph {
+		for i := 0; i < edgesPerNode; i++ {
+			graph[node] = append(graph[node], [2]int{r.Intn(nodes), 1 + r.Intn(100)})
+		}
+	}
+	return graph
+}
+
+func BenchmarkIndexedPriorityQueueDijkstra(b *testing.B) {
+	graph := randGraph(10000, 16)
+	for _, arity := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("d=%d", arity), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dijkstra(graph, arity)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package gostrutures
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultLatencyBuckets are the upper bounds of the latency histogram buckets used when
+// none are given, from one microsecond to ten seconds.
+var DefaultLatencyBuckets = []time.Duration{
+	time.Microsecond,
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+}
+
+// LatencyHistogram counts the time the elements spent in the queue. Counts[i] is the number
+// of elements whose latency was less or equal than Bounds[i] and greater than the previous
+// bound, and the last count is for the ones greater than every bound.
+type LatencyHistogram struct {
+	Bounds []time.Duration
+	Counts []uint64
+	Count  uint64
+	Sum    time.Duration
+	Max    time.Duration
+}
+
+func newLatencyHistogram(bounds []time.Duration) LatencyHistogram {
+	return LatencyHistogram{Bounds: bounds, Counts: make([]uint64, len(bounds)+1)}
+}
+
+func (h *LatencyHistogram) observe(latency time.Duration) {
+	i := 0
+	for i < len(h.Bounds) && latency > h.Bounds[i] {
+		i++
+	}
+	h.Counts[i]++
+	h.Count++
+	h.Sum += latency
+	h.Max = max(h.Max, latency)
+}
+
+// Mean returns the average latency, or zero if nothing was observed.
+func (h LatencyHistogram) Mean() time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+	return h.Sum / time.Duration(h.Count)
+}
+
+// QueueStats is a point in time view of the metrics of an InstrumentedQueue.
+type QueueStats struct {
+	Pushes   uint64
+	Pops     uint64
+	Size     int
+	PeakSize int
+	Latency  LatencyHistogram
+}
+
+// QueueHook is notified of every operation of an InstrumentedQueue, so the metrics can be
+// forwarded to any metrics library. It's called outside the queue lock, after the operation.
+type QueueHook interface {
+	// OnPush is called after an element is pushed, with the size of the queue.
+	OnPush(size int)
+	// OnPop is called after an element is popped, with the size of the queue and the time
+	// the element spent in it.
+	OnPop(size int, latency time.Duration)
+}
+
+// InstrumentedQueueOptions configures an InstrumentedQueue. Every field is optional.
+type InstrumentedQueueOptions struct {
+	// Clock measures the latencies, SystemClock if nil.
+	Clock Clock
+	// Hook is notified of every operation if not nil.
+	Hook QueueHook
+	// LatencyBuckets are the upper bounds of the latency histogram in increasing order,
+	// DefaultLatencyBuckets if empty.
+	LatencyBuckets []time.Duration
+}
+
+// InstrumentedQueue is a FIFO queue safe for concurrent use by multiple goroutines that tracks
+// the number of pushes and pops, the current and peak size and the time the elements
+// spend in the queue.
+type InstrumentedQueue struct {
+	mu      sync.Mutex
+	entries ring[instrumentedEntry]
+	clock   Clock
+	hook    QueueHook
+	stats   QueueStats
+}
+
+type instrumentedEntry struct {
+	item     Item
+	pushedAt time.Time
+}
+
+// NewInstrumentedQueue build an empty InstrumentedQueue. If options is nil the defaults are used.
+func NewInstrumentedQueue(options *InstrumentedQueueOptions) *InstrumentedQueue {
+	if options == nil {
+		options = &InstrumentedQueueOptions{}
+	}
+
+	q := &InstrumentedQueue{clock: options.Clock, hook: options.Hook}
+	if q.clock == nil {
+		q.clock = SystemClock
+	}
+	buckets := options.LatencyBuckets
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	q.stats.Latency = newLatencyHistogram(append([]time.Duration(nil), buckets...))
+	return q
+}
+
+// Push adds an element (Item) to the end of the queue.
+func (q *InstrumentedQueue) Push(value Item) {
+	q.mu.Lock()
+	q.entries.push(instrumentedEntry{value, q.clock.Now()})
+	q.stats.Pushes++
+	size := q.entries.size
+	q.stats.PeakSize = max(q.stats.PeakSize, size)
+	q.mu.Unlock()
+
+	if q.hook != nil {
+		q.hook.OnPush(size)
+	}
+}
+
+// Pop retrieves and removes the head of this queue, or returns nil if this queue is empty.
+// Use TryPop to tell apart an empty queue from a nil Item.
+func (q *InstrumentedQueue) Pop() Item {
+	item, _ := q.TryPop()
+	return item
+}
+
+// TryPop retrieves and removes the head of this queue. Return false if this queue is empty.
+func (q *InstrumentedQueue) TryPop() (Item, bool) {
+	q.mu.Lock()
+	entry, ok := q.entries.pop()
+	if !ok {
+		q.mu.Unlock()
+		return nil, false
+	}
+	latency := q.clock.Now().Sub(entry.pushedAt)
+	q.stats.Pops++
+	q.stats.Latency.observe(latency)
+	size := q.entries.size
+	q.mu.Unlock()
+
+	if q.hook != nil {
+		q.hook.OnPop(size, latency)
+	}
+	return entry.item, true
+}
+
+// Size returns the number of Items in the queue
+func (q *InstrumentedQueue) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.entries.size
+}
+
+// IsEmpty returns true if the queue is empty
+func (q *InstrumentedQueue) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Stats returns a copy of the metrics of the queue.
+func (q *InstrumentedQueue) Stats() QueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stats := q.stats
+	stats.Size = q.entries.size
+	stats.Latency.Bounds = append([]time.Duration(nil), q.stats.Latency.Bounds...)
+	stats.Latency.Counts = append([]uint64(nil), q.stats.Latency.Counts...)
+	return stats
+}
+
+// ResetStats clears the counters and the histogram, and sets the peak size to the current size.
+func (q *InstrumentedQueue) ResetStats() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.stats = QueueStats{
+		PeakSize: q.entries.size,
+		Latency:  newLatencyHistogram(q.stats.Latency.Bounds),
+	}
+}
@@ -0,0 +1,172 @@
+package gostrutures_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+type recordingHook struct {
+	mu        sync.Mutex
+	pushSizes []int
+	popSizes  []int
+	latencies []time.Duration
+}
+
+func (h *recordingHook) OnPush(size int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.pushSizes = append(h.pushSizes, size)
+}
+
+func (h *recordingHook) OnPop(size int, latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.popSizes = append(h.popSizes, size)
+	h.latencies = append(h.latencies, latency)
+}
+
+func TestInstrumentedQueueStats(t *testing.T) {
+	clock := newFakeClock()
+	q := gostrutures.NewInstrumentedQueue(&gostrutures.InstrumentedQueueOptions{
+		Clock:          clock,
+		LatencyBuckets: []time.Duration{time.Millisecond, time.Second},
+	})
+
+	q.Push(1)
+	q.Push(2)
+	clock.Advance(500 * time.Microsecond)
+	q.Push(3)
+	q.Pop()
+	clock.Advance(time.Second)
+	q.Pop()
+	clock.Advance(time.Second)
+	q.Pop()
+
+	stats := q.Stats()
+	tt := []struct {
+		name     string
+		expected interface{}
+		result   interface{}
+	}{
+		{"pushes", uint64(3), stats.Pushes},
+		{"pops", uint64(3), stats.Pops},
+		{"size", 0, stats.Size},
+		{"peak size", 3, stats.PeakSize},
+		{"latency count", uint64(3), stats.Latency.Count},
+		{"latency max", 2 * time.Second, stats.Latency.Max},
+		{"latency mean", (time.Millisecond + 3*time.Second) / 3, stats.Latency.Mean()},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.result != tc.expected {
+				t.Errorf("Expected %v to be '%v'. Got '%v'", tc.name, tc.expected, tc.result)
+			}
+		})
+	}
+
+	expectedCounts := []uint64{1, 0, 2}
+	for i := range expectedCounts {
+		if stats.Latency.Counts[i] != expectedCounts[i] {
+			t.Fatalf("Expected latency counts to be '%v'. Got '%v'", expectedCounts, stats.Latency.Counts)
+		}
+	}
+}
+
+func TestInstrumentedQueueHook(t *testing.T) {
+	clock := newFakeClock()
+	hook := &recordingHook{}
+	q := gostrutures.NewInstrumentedQueue(&gostrutures.InstrumentedQueueOptions{Clock: clock, Hook: hook})
+
+	q.Push("a")
+	q.Push("b")
+	clock.Advance(time.Millisecond)
+	q.Pop()
+	q.Pop()
+	q.Pop()
+
+	expectedPushSizes, expectedPopSizes := []int{1, 2}, []int{1, 0}
+	if !equalIntSlices(hook.pushSizes, expectedPushSizes) {
+		t.Errorf("Expected push sizes to be '%v'. Got '%v'", expectedPushSizes, hook.pushSizes)
+	}
+
+	if !equalIntSlices(hook.popSizes, expectedPopSizes) {
+		t.Errorf("Expected pop sizes to be '%v'. Got '%v'", expectedPopSizes, hook.popSizes)
+	}
+
+	if hook.latencies[0] != time.Millisecond {
+		t.Errorf("Expected pop latency to be '%v'. Got '%v'", time.Millisecond, hook.latencies[0])
+	}
+}
+
+func TestInstrumentedQueueTryPop(t *testing.T) {
+	q := gostrutures.NewInstrumentedQueue(nil)
+	q.Push(nil)
+
+	if item, ok := q.TryPop(); item != nil || !ok {
+		t.Errorf("Expected try pop to be 'nil, true'. Got '%v, %v'", item, ok)
+	}
+
+	if item, ok := q.TryPop(); item != nil || ok {
+		t.Errorf("Expected try pop to be 'nil, false'. Got '%v, %v'", item, ok)
+	}
+
+	if pops := q.Stats().Pops; pops != 1 {
+		t.Errorf("Expected pops to be '%v'. Got '%v'", 1, pops)
+	}
+}
+
+func TestInstrumentedQueueResetStats(t *testing.T) {
+	q := gostrutures.NewInstrumentedQueue(nil)
+	q.Push(1)
+	q.Push(2)
+	q.Pop()
+	q.ResetStats()
+
+	stats := q.Stats()
+	if stats.Pushes != 0 || stats.Pops != 0 || stats.Latency.Count != 0 {
+		t.Errorf("Expected counters to be reset. Got '%+v'", stats)
+	}
+
+	if stats.Size != 1 || stats.PeakSize != 1 {
+		t.Errorf("Expected size and peak size to be '1, 1'. Got '%v, %v'", stats.Size, stats.PeakSize)
+	}
+
+	if len(stats.Latency.Counts) != len(gostrutures.DefaultLatencyBuckets)+1 {
+		t.Errorf("Expected latency buckets to be kept. Got '%v'", stats.Latency.Counts)
+	}
+}
+
+func TestInstrumentedQueueConcurrent(t *testing.T) {
+	q := gostrutures.NewInstrumentedQueue(nil)
+	const producers, perProducer = 8, 1000
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Push(i)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for popped := 0; popped < perProducer; {
+				if _, ok := q.TryPop(); ok {
+					popped++
+				}
+				_ = q.Stats()
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := q.Stats()
+	if stats.Pushes != producers*perProducer || stats.Pops != producers*perProducer || stats.Size != 0 {
+		t.Errorf("Expected pushes, pops and size to be '%v, %v, 0'. Got '%+v'", producers*perProducer, producers*perProducer, stats)
+	}
+}
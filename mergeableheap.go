@@ -0,0 +1,60 @@
+package gostrutures
+
+// MergeableHeap is a min-heap of elements (Item) ordered by an integer key, that can be
+// melded with another heap of the same implementation in O(1).
+//
+// Read/Write operations are not safe for concurrent mutation by multiple goroutines.
+type MergeableHeap interface {
+	// Insert adds an element (Item) with the given key and returns its node.
+	Insert(value Item, key int) HeapNode
+	// FindMin returns but does not remove, the element with the minimal key and its key.
+	// Return false if the heap is empty.
+	FindMin() (Item, int, bool)
+	// DeleteMin retrieves and removes the element with the minimal key and its key.
+	// Return false if the heap is empty.
+	DeleteMin() (Item, int, bool)
+	// DecreaseKey lowers the key of the node. Return false if the node is not in the heap
+	// or the key is greater than the current one.
+	DecreaseKey(node HeapNode, key int) bool
+	// Meld moves all the elements of other into the heap, leaving other empty. It panics
+	// if other is not of the same implementation.
+	Meld(other MergeableHeap)
+	// Len returns the number of Items in the heap.
+	Len() int
+}
+
+// HeapNode references an element inserted into a MergeableHeap.
+type HeapNode interface {
+	// Item returns the element (Item) referenced by the node.
+	Item() Item
+	// Key returns the current key of the element referenced by the node.
+	Key() int
+}
+
+// heapOwner identifies the heap a node belongs to. When a heap is melded into another one
+// its owner is forwarded to the owner of the other heap, so the nodes don't need to be updated.
+type heapOwner struct {
+	forward *heapOwner
+}
+
+// resolve returns the owner at the end of the forwarding chain, compressing the path.
+func (o *heapOwner) resolve() *heapOwner {
+	for o.forward != nil {
+		if o.forward.forward != nil {
+			o.forward = o.forward.forward
+		}
+		o = o.forward
+	}
+	return o
+}
+
+// forwardTo forwards the owner of a heap melded into other, returning the owner of other.
+func (o *heapOwner) forwardTo(other *heapOwner) *heapOwner {
+	if other == nil {
+		other = &heapOwner{}
+	}
+	if o != nil {
+		o.forward = other
+	}
+	return other
+}
@@ -0,0 +1,242 @@
+package gostrutures_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+var mergeableHeaps = []struct {
+	name    string
+	newHeap func() gostrutures.MergeableHeap
+}{
+	{"pairing heap", func() gostrutures.MergeableHeap { return gostrutures.NewPairingHeap() }},
+	{"fibonacci heap", func() gostrutures.MergeableHeap { return gostrutures.NewFibonacciHeap() }},
+}
+
+func deleteAllKeys(h gostrutures.MergeableHeap) []int {
+	var keys []int
+	for _, key, ok := h.DeleteMin(); ok; _, key, ok = h.DeleteMin() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestMergeableHeapDeleteMin(t *testing.T) {
+	for _, impl := range mergeableHeaps {
+		t.Run(impl.name, func(t *testing.T) {
+			h := impl.newHeap()
+			for _, key := range []int{5, 3, 8, 1, 9, 2, 7} {
+				h.Insert(key*10, key)
+			}
+
+			if item, key, ok := h.FindMin(); item != 10 || key != 1 || !ok {
+				t.Errorf("Expected find min to be '10, 1, true'. Got '%v, %v, %v'", item, key, ok)
+			}
+
+			if h.Len() != 7 {
+				t.Errorf("Expected heap len to be '%v'. Got '%v'", 7, h.Len())
+			}
+
+			expected := []int{1, 2, 3, 5, 7, 8, 9}
+			if result := deleteAllKeys(h); !equalIntSlices(result, expected) {
+				t.Errorf("Expected delete min order to be '%v'. Got '%v'", expected, result)
+			}
+		})
+	}
+}
+
+func TestMergeableHeapEmpty(t *testing.T) {
+	for _, impl := range mergeableHeaps {
+		t.Run(impl.name, func(t *testing.T) {
+			h := impl.newHeap()
+
+			if item, _, ok := h.FindMin(); item != nil || ok {
+				t.Errorf("Expected find min to be 'nil, false'. Got '%v, %v'", item, ok)
+			}
+
+			if item, _, ok := h.DeleteMin(); item != nil || ok {
+				t.Errorf("Expected delete min to be 'nil, false'. Got '%v, %v'", item, ok)
+			}
+		})
+	}
+}
+
+func TestMergeableHeapDecreaseKey(t *testing.T) {
+	for _, impl := range mergeableHeaps {
+		t.Run(impl.name, func(t *testing.T) {
+			h := impl.newHeap()
+			nodes := make([]gostrutures.HeapNode, 10)
+			for i := range nodes {
+				nodes[i] = h.Insert(i, 100+i)
+			}
+			// force a tree structure so the decreased nodes are not roots
+			h.DeleteMin()
+
+			if !h.DecreaseKey(nodes[7], 5) || !h.DecreaseKey(nodes[9], 1) {
+				t.Fatal("Expected decrease key to be true")
+			}
+
+			if nodes[9].Key() != 1 || nodes[9].Item() != 9 {
+				t.Errorf("Expected node to be '9, 1'. Got '%v, %v'", nodes[9].Item(), nodes[9].Key())
+			}
+
+			if h.DecreaseKey(nodes[3], 200) {
+				t.Error("Expected decrease to a greater key to be false")
+			}
+
+			if h.DecreaseKey(nodes[0], 0) {
+				t.Error("Expected decrease key of a deleted node to be false")
+			}
+
+			expected := []int{1, 5, 101, 102, 103, 104, 105, 106, 108}
+			if result := deleteAllKeys(h); !equalIntSlices(result, expected) {
+				t.Errorf("Expected delete min order to be '%v'. Got '%v'", expected, result)
+			}
+		})
+	}
+}
+
+func TestMergeableHeapMeld(t *testing.T) {
+	for _, impl := range mergeableHeaps {
+		t.Run(impl.name, func(t *testing.T) {
+			a, b, c := impl.newHeap(), impl.newHeap(), impl.newHeap()
+			for _, key := range []int{4, 8, 6} {
+				a.Insert(nil, key)
+			}
+			bNode := b.Insert(nil, 7)
+			b.Insert(nil, 3)
+			cNode := c.Insert(nil, 9)
+
+			b.Meld(c)
+			a.Meld(b)
+			a.Meld(impl.newHeap())
+
+			if a.Len() != 6 || b.Len() != 0 || c.Len() != 0 {
+				t.Fatalf("Expected heap lens to be '6, 0, 0'. Got '%v, %v, %v'", a.Len(), b.Len(), c.Len())
+			}
+
+			if b.DecreaseKey(bNode, 1) {
+				t.Error("Expected decrease key from a melded heap to be false")
+			}
+
+			if !a.DecreaseKey(bNode, 1) || !a.DecreaseKey(cNode, 2) {
+				t.Error("Expected decrease key of a melded node to be true")
+			}
+
+			// the melded heap can be reused
+			bNew := b.Insert(nil, 10)
+			if a.DecreaseKey(bNew, 0) {
+				t.Error("Expected decrease key of a node from other heap to be false")
+			}
+
+			expected := []int{1, 2, 3, 4, 6, 8}
+			if result := deleteAllKeys(a); !equalIntSlices(result, expected) {
+				t.Errorf("Expected delete min order to be '%v'. Got '%v'", expected, result)
+			}
+		})
+	}
+}
+
+func TestMergeableHeapMeldDifferentImplementation(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected meld of a different implementation to panic")
+		}
+	}()
+	gostrutures.NewPairingHeap().Meld(gostrutures.NewFibonacciHeap())
+}
+
+func TestMergeableHeapRandomOperations(t *testing.T) {
+	for _, impl := range mergeableHeaps {
+		t.Run(impl.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			h := impl.newHeap()
+			live := make(map[gostrutures.HeapNode]bool)
+
+			for step := 0; step < 5000; step++ {
+				switch op := r.Intn(10); {
+				case op < 4:
+					live[h.Insert(step, r.Intn(1000))] = true
+				case op < 6:
+					other := impl.newHeap()
+					for i := r.Intn(5); i > 0; i-- {
+						live[other.Insert(step, r.Intn(1000))] = true
+					}
+					h.Meld(other)
+				case op < 8:
+					for node := range live {
+						if !h.DecreaseKey(node, node.Key()-r.Intn(100)) {
+							t.Fatalf("Expected decrease key of a live node to be true")
+						}
+						break
+					}
+				default:
+					min, found := 0, false
+					for node := range live {
+						if !found || node.Key() < min {
+							min, found = node.Key(), true
+						}
+					}
+					_, key, ok := h.DeleteMin()
+					if ok != found || (ok && key != min) {
+						t.Fatalf("Expected delete min to be '%v'. Got '%v, %v'", min, key, ok)
+					}
+					for node := range live {
+						if ok && node.Key() == key && !h.DecreaseKey(node, key) {
+							delete(live, node)
+							break
+						}
+					}
+				}
+
+				if h.Len() != len(live) {
+					t.Fatalf("Expected heap len to be '%v'. Got '%v'", len(live), h.Len())
+				}
+			}
+
+			keys := make([]int, 0, len(live))
+			for node := range live {
+				keys = append(keys, node.Key())
+			}
+			sort.Ints(keys)
+			if result := deleteAllKeys(h); !equalIntSlices(result, keys) {
+				t.Errorf("Expected delete min order to be '%v'. Got '%v'", keys, result)
+			}
+		})
+	}
+}
+
+func equalIntSlices(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkMergeableHeapMeld(b *testing.B) {
+	for _, impl := range mergeableHeaps {
+		b.Run(impl.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				h := impl.newHeap()
+				for shard := 0; shard < 64; shard++ {
+					other := impl.newHeap()
+					for j := 0; j < 64; j++ {
+						other.Insert(nil, (shard*7919+j*104729)%10007)
+					}
+					h.Meld(other)
+				}
+				for h.Len() > 0 {
+					h.DeleteMin()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package gostrutures
+
+// SchedulingPolicy decides how a MultiLevelQueue shares the pops between its levels.
+type SchedulingPolicy int
+
+const (
+	// WeightedRoundRobin visits the levels in order, popping up to Weight elements from each one.
+	WeightedRoundRobin SchedulingPolicy = iota
+	// DeficitRoundRobin visits the levels in order, adding Weight to the deficit of each one and
+	// popping elements while their cost fits in it. The unused deficit is kept for the next round
+	// unless the level is left empty, so levels with expensive elements get their share over time.
+	DeficitRoundRobin
+)
+
+// Level configures a level of a MultiLevelQueue.
+type Level struct {
+	// Weight is the number of elements (WeightedRoundRobin) or the cost (DeficitRoundRobin) the
+	// level can pop in a round. It must be positive.
+	Weight int
+	// Cap is the maximum number of elements in the level, zero for unbounded.
+	Cap int
+}
+
+// MultiLevelQueueOptions configures a MultiLevelQueue.
+type MultiLevelQueueOptions struct {
+	Policy SchedulingPolicy
+	// Levels are visited in order in every round, so the first level is served first.
+	Levels []Level
+	// Cost returns the cost of an element for DeficitRoundRobin, every element costs 1 if nil.
+	Cost func(Item) int
+}
+
+// MultiLevelQueue serves several priority classes, each one in its own FIFO Queue, sharing
+// the pops between them in proportion to their weights so no level starves.
+type MultiLevelQueue struct {
+	policy  SchedulingPolicy
+	cost    func(Item) int
+	levels  []multiLevel
+	current int
+	size    int
+}
+
+type multiLevel struct {
+	Level
+	queue  Queue
+	credit int
+}
+
+// NewMultiLevelQueue build an empty MultiLevelQueue. It panics if there are no levels or a
+// weight is not positive.
+func NewMultiLevelQueue(options MultiLevelQueueOptions) *MultiLevelQueue {
+	if len(options.Levels) == 0 {
+		panic("gostrutures: MultiLevelQueue must have at least one level")
+	}
+
+	q := &MultiLevelQueue{
+		policy: options.Policy,
+		cost:   options.Cost,
+		levels: make([]multiLevel, len(options.Levels)),
+	}
+	if q.cost == nil || q.policy == WeightedRoundRobin {
+		q.cost = func(Item) int { return 1 }
+	}
+	for i, level := range options.Levels {
+		if level.Weight <= 0 {
+			panic("gostrutures: MultiLevelQueue level weight must be positive")
+		}
+		q.levels[i].Level = level
+	}
+	q.levels[0].credit = q.levels[0].Weight
+	return q
+}
+
+// Push adds an element (Item) to the end of the level. Return false if the level is full.
+// It panics if the level doesn't exist.
+func (q *MultiLevelQueue) Push(level int, value Item) bool {
+	l := &q.levels[level]
+	if l.Cap > 0 && l.queue.Size() >= l.Cap {
+		return false
+	}
+
+	l.queue.Push(value)
+	q.size++
+	return true
+}
+
+// Pop retrieves and removes the next element according to the scheduling policy, with
+// the level it was taken from. Return false if the queue is empty.
+func (q *MultiLevelQueue) Pop() (Item, int, bool) {
+	if q.size == 0 {
+		return nil, 0, false
+	}
+
+	for {
+		l := &q.levels[q.current]
+		if head, ok := l.queue.TryPeek(); ok {
+			if cost := q.cost(head); cost <= l.credit {
+				l.queue.Pop()
+				l.credit -= cost
+				q.size--
+				if l.queue.IsEmpty() {
+					// an empty level doesn't keep its credit for the next round
+					l.credit = 0
+				}
+				return head, q.current, true
+			}
+		} else {
+			l.credit = 0
+		}
+		q.nextLevel()
+	}
+}
+
+// nextLevel moves the round to the next level and gives it its credit.
+func (q *MultiLevelQueue) nextLevel() {
+	q.current = (q.current + 1) % len(q.levels)
+	l := &q.levels[q.current]
+	if q.policy == DeficitRoundRobin {
+		l.credit += l.Weight
+	} else {
+		l.credit = l.Weight
+	}
+}
+
+// Size returns the number of Items in the queue
+func (q *MultiLevelQueue) Size() int {
+	return q.size
+}
+
+// LevelSize returns the number of Items in the level. It panics if the level doesn't exist.
+func (q *MultiLevelQueue) LevelSize(level int) int {
+	return q.levels[level].queue.Size()
+}
+
+// Levels returns the number of levels of the queue.
+func (q *MultiLevelQueue) Levels() int {
+	return len(q.levels)
+}
+
+// IsEmpty returns true if the queue is empty
+func (q *MultiLevelQueue) IsEmpty() bool {
+	return q.size == 0
+}
@@ -0,0 +1,167 @@
+package gostrutures_test
+
+import (
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func popAllLevels(q *gostrutures.MultiLevelQueue) []int {
+	var levels []int
+	for _, level, ok := q.Pop(); ok; _, level, ok = q.Pop() {
+		levels = append(levels, level)
+	}
+	return levels
+}
+
+func TestMultiLevelQueueWeightedRoundRobin(t *testing.T) {
+	tt := []struct {
+		name     string
+		sizes    []int
+		expected []int
+	}{
+		{"every level with elements", []int{4, 3, 2}, []int{0, 0, 0, 1, 1, 2, 0, 1, 2}},
+		{"empty level is skipped", []int{5, 0, 1}, []int{0, 0, 0, 2, 0, 0}},
+		{"only the lowest level", []int{0, 0, 2}, []int{2, 2}},
+		{"empty queue", []int{0, 0, 0}, nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			q := gostrutures.NewMultiLevelQueue(gostrutures.MultiLevelQueueOptions{
+				Levels: []gostrutures.Level{{Weight: 3}, {Weight: 2}, {Weight: 1}},
+			})
+			for level, size := range tc.sizes {
+				for i := 0; i < size; i++ {
+					q.Push(level, i)
+				}
+			}
+
+			if result := popAllLevels(q); !equalIntSlices(result, tc.expected) {
+				t.Errorf("Expected pop levels to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestMultiLevelQueueFIFOWithinLevel(t *testing.T) {
+	q := gostrutures.NewMultiLevelQueue(gostrutures.MultiLevelQueueOptions{
+		Levels: []gostrutures.Level{{Weight: 1}, {Weight: 1}},
+	})
+	q.Push(1, "b1")
+	q.Push(0, "a1")
+	q.Push(1, "b2")
+	q.Push(0, "a2")
+
+	for _, expected := range []string{"a1", "b1", "a2", "b2"} {
+		if item, _, ok := q.Pop(); item != expected || !ok {
+			t.Errorf("Expected pop to be '%v, true'. Got '%v, %v'", expected, item, ok)
+		}
+	}
+}
+
+func TestMultiLevelQueueDoesNotStarve(t *testing.T) {
+	q := gostrutures.NewMultiLevelQueue(gostrutures.MultiLevelQueueOptions{
+		Levels: []gostrutures.Level{{Weight: 10}, {Weight: 1}},
+	})
+	q.Push(1, "low")
+
+	// keep the high level always busy
+	for i := 0; i < 11; i++ {
+		q.Push(0, i)
+		if _, level, _ := q.Pop(); level == 1 {
+			return
+		}
+	}
+	t.Error("Expected the low level to be served while the high level is busy")
+}
+
+func TestMultiLevelQueueDeficitRoundRobin(t *testing.T) {
+	q := gostrutures.NewMultiLevelQueue(gostrutures.MultiLevelQueueOptions{
+		Policy: gostrutures.DeficitRoundRobin,
+		Levels: []gostrutures.Level{{Weight: 5}, {Weight: 5}},
+		Cost:   func(item gostrutures.Item) int { return item.(int) },
+	})
+	for _, cost := range []int{2, 2, 2, 2, 2} {
+		q.Push(0, cost)
+	}
+	for _, cost := range []int{8, 1} {
+		q.Push(1, cost)
+	}
+
+	// level 1 needs two rounds of deficit to pop the element of cost 8, while level 0 uses
+	// the deficit left from the first round to pop three elements in the second one
+	expected := []int{0, 0, 0, 0, 0, 1, 1}
+	if result := popAllLevels(q); !equalIntSlices(result, expected) {
+		t.Errorf("Expected pop levels to be '%v'. Got '%v'", expected, result)
+	}
+}
+
+func TestMultiLevelQueueCaps(t *testing.T) {
+	q := gostrutures.NewMultiLevelQueue(gostrutures.MultiLevelQueueOptions{
+		Levels: []gostrutures.Level{{Weight: 1, Cap: 2}, {Weight: 1}},
+	})
+
+	tt := []struct {
+		name     string
+		level    int
+		expected bool
+	}{
+		{"level with room", 0, true},
+		{"level reaches cap", 0, true},
+		{"full level", 0, false},
+		{"unbounded level", 1, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := q.Push(tc.level, tc.name); result != tc.expected {
+				t.Errorf("Expected push to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+
+	if q.Size() != 3 || q.LevelSize(0) != 2 || q.LevelSize(1) != 1 {
+		t.Errorf("Expected sizes to be '3, 2, 1'. Got '%v, %v, %v'", q.Size(), q.LevelSize(0), q.LevelSize(1))
+	}
+
+	q.Pop()
+	if !q.Push(0, "room again") {
+		t.Error("Expected push after a pop from a full level to be true")
+	}
+}
+
+func TestMultiLevelQueueEmpty(t *testing.T) {
+	q := gostrutures.NewMultiLevelQueue(gostrutures.MultiLevelQueueOptions{
+		Levels: []gostrutures.Level{{Weight: 1}},
+	})
+
+	if !q.IsEmpty() || q.Levels() != 1 {
+		t.Errorf("Expected queue to be empty with '1' level. Got '%v, %v'", q.IsEmpty(), q.Levels())
+	}
+
+	if item, _, ok := q.Pop(); item != nil || ok {
+		t.Errorf("Expected pop to be 'nil, false'. Got '%v, %v'", item, ok)
+	}
+}
+
+func TestNewMultiLevelQueueInvalidOptions(t *testing.T) {
+	tt := []struct {
+		name   string
+		levels []gostrutures.Level
+	}{
+		{"no levels", nil},
+		{"zero weight", []gostrutures.Level{{Weight: 1}, {Weight: 0}}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected new multi level queue to panic")
+				}
+			}()
+			gostrutures.NewMultiLevelQueue(gostrutures.MultiLevelQueueOptions{Levels: tc.levels})
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package gostrutures
+
+// PairingHeap is a MergeableHeap implemented as a pairing heap. Insert, FindMin and Meld
+// take O(1), while DeleteMin and DecreaseKey take O(log n) amortized.
+type PairingHeap struct {
+	root  *pairingNode
+	size  int
+	owner *heapOwner
+}
+
+type pairingNode struct {
+	item Item
+	key  int
+	// child is the leftmost child, sibling the next one to the right and prev either the
+	// left sibling or the parent for the leftmost child.
+	child, sibling, prev *pairingNode
+	// owner identifies the heap the node belongs to, or nil once deleted.
+	owner *heapOwner
+}
+
+func (n *pairingNode) Item() Item {
+	return n.item
+}
+
+func (n *pairingNode) Key() int {
+	return n.key
+}
+
+// NewPairingHeap build an empty PairingHeap.
+func NewPairingHeap() *PairingHeap {
+	return &PairingHeap{}
+}
+
+// Insert adds an element (Item) with the given key and returns its node.
+func (h *PairingHeap) Insert(value Item, key int) HeapNode {
+	if h.owner == nil {
+		h.owner = &heapOwner{}
+	}
+	node := &pairingNode{item: value, key: key, owner: h.owner}
+	h.root = linkPairing(h.root, node)
+	h.size++
+	return node
+}
+
+// FindMin returns but does not remove, the element with the minimal key and its key.
+// Return false if the heap is empty.
+func (h *PairingHeap) FindMin() (Item, int, bool) {
+	if h.root == nil {
+		return nil, 0, false
+	}
+	return h.root.item, h.root.key, true
+}
+
+// DeleteMin retrieves and removes the element with the minimal key and its key.
+// Return false if the heap is empty.
+func (h *PairingHeap) DeleteMin() (Item, int, bool) {
+	min := h.root
+	if min == nil {
+		return nil, 0, false
+	}
+
+	h.root = mergePairs(min.child)
+	if h.root != nil {
+		h.root.prev = nil
+	}
+	h.size--
+	min.child, min.owner = nil, nil
+	return min.item, min.key, true
+}
+
+// DecreaseKey lowers the key of the node. Return false if the node is not in the heap
+// or the key is greater than the current one.
+func (h *PairingHeap) DecreaseKey(node HeapNode, key int) bool {
+	n, ok := node.(*pairingNode)
+	if !ok || n.owner == nil || n.owner.resolve() != h.owner || key > n.key {
+		return false
+	}
+
+	n.key = key
+	if n == h.root {
+		return true
+	}
+
+	// cut the subtree of the node and link it again with the root
+	if n.prev.child == n {
+		n.prev.child = n.sibling
+	} else {
+		n.prev.sibling = n.sibling
+	}
+	if n.sibling != nil {
+		n.sibling.prev = n.prev
+	}
+	n.sibling, n.prev = nil, nil
+	h.root = linkPairing(h.root, n)
+	return true
+}
+
+// Meld moves all the elements of other into the heap, leaving other empty. It panics
+// if other is not a *PairingHeap.
+func (h *PairingHeap) Meld(other MergeableHeap) {
+	o := other.(*PairingHeap)
+	if o == h || o.root == nil {
+		return
+	}
+
+	h.owner = o.owner.forwardTo(h.owner)
+	h.root = linkPairing(h.root, o.root)
+	h.size += o.size
+	o.root, o.size, o.owner = nil, 0, nil
+}
+
+// Len returns the number of Items in the heap.
+func (h *PairingHeap) Len() int {
+	return h.size
+}
+
+// linkPairing makes the root with the greater key the leftmost child of the other one and
+// returns the new root.
+func linkPairing(a, b *pairingNode) *pairingNode {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if b.key < a.key {
+		a, b = b, a
+	}
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+// mergePairs links the siblings in pairs from left to right, and then links the resulting
+// trees from right to left.
+func mergePairs(first *pairingNode) *pairingNode {
+	var pairs []*pairingNode
+	for first != nil {
+		a, b := first, first.sibling
+		first = nil
+		a.sibling, a.prev = nil, nil
+		if b != nil {
+			first = b.sibling
+			b.sibling, b.prev = nil, nil
+		}
+		pairs = append(pairs, linkPairing(a, b))
+	}
+
+	var root *pairingNode
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = linkPairing(pairs[i], root)
+	}
+	return root
+}
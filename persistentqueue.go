@@ -0,0 +1,468 @@
+package gostrutures
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	segmentExt       = ".seg"
+	commitFile       = "commit"
+	recordHeaderSize = 8
+	// DefaultSegmentSize is the size in bytes after which a PersistentQueue starts a new segment.
+	DefaultSegmentSize = 64 << 20
+)
+
+var (
+	// ErrQueueEmpty is returned when popping or peeking from an empty queue.
+	ErrQueueEmpty = errors.New("gostrutures: queue empty")
+	// ErrCorruptedQueue is returned when the files of a PersistentQueue can't be recovered.
+	ErrCorruptedQueue = errors.New("gostrutures: corrupted queue")
+)
+
+// Codec converts the elements (Item) of a PersistentQueue to and from bytes.
+type Codec interface {
+	Encode(value Item) ([]byte, error)
+	Decode(data []byte) (Item, error)
+}
+
+// BytesCodec is a Codec for []byte elements.
+type BytesCodec struct{}
+
+// Encode returns the element, that must be a []byte.
+func (BytesCodec) Encode(value Item) ([]byte, error) {
+	b, ok := value.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("gostrutures: BytesCodec can't encode %T", value)
+	}
+	return b, nil
+}
+
+// Decode returns a copy of data.
+func (BytesCodec) Decode(data []byte) (Item, error) {
+	return append([]byte{}, data...), nil
+}
+
+// StringCodec is a Codec for string elements.
+type StringCodec struct{}
+
+// Encode returns the bytes of the element, that must be a string.
+func (StringCodec) Encode(value Item) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("gostrutures: StringCodec can't encode %T", value)
+	}
+	return []byte(s), nil
+}
+
+// Decode returns data as a string.
+func (StringCodec) Decode(data []byte) (Item, error) {
+	return string(data), nil
+}
+
+// PersistentQueueOptions configures a PersistentQueue.
+type PersistentQueueOptions struct {
+	// SegmentSize is the size in bytes after which a new segment file is started.
+	// Defaults to DefaultSegmentSize.
+	SegmentSize int64
+	// Sync flushes the files to stable storage after every Push and Pop.
+	Sync bool
+}
+
+// PersistentQueue is a FIFO queue stored in a directory, so its elements (Item) survive restarts.
+//
+// Pushes are appended as checksummed records to segment files, that work as a write-ahead log,
+// and pops are recorded by persisting the offset of the head into a commit file. On open the
+// queue is recovered from the files, dropping a partially written last record. The segments
+// whose records were all popped are deleted.
+//
+// Read/Write operations are not safe for concurrent mutation by multiple goroutines.
+type PersistentQueue struct {
+	dir     string
+	codec   Codec
+	options PersistentQueueOptions
+
+	segments []*segment
+	// head is the index of the next record to pop and tail the index of the next one to push.
+	head, tail int64
+	// headOffset is the position of the head record into the first segment.
+	headOffset int64
+	writer     *os.File
+	reader     *os.File
+	// failed is the error that stops the pushes after a failed write couldn't be discarded.
+	failed error
+}
+
+// segment is a file holding the records from first to first+count-1.
+type segment struct {
+	first int64
+	count int64
+	size  int64
+}
+
+func (s *segment) name() string {
+	return fmt.Sprintf("%020d%s", s.first, segmentExt)
+}
+
+// OpenPersistentQueue opens the queue stored in dir, creating it if it doesn't exist. If
+// options is nil the default options are used.
+func OpenPersistentQueue(dir string, codec Codec, options *PersistentQueueOptions) (*PersistentQueue, error) {
+	q := &PersistentQueue{dir: dir, codec: codec}
+	if options != nil {
+		q.options = *options
+	}
+	if q.options.SegmentSize <= 0 {
+		q.options.SegmentSize = DefaultSegmentSize
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := q.recover(); err != nil {
+		q.Close()
+		return nil, err
+	}
+	return q, nil
+}
+
+// Push adds an element (Item) to the end of the queue. If the write fails the partial record
+// is discarded, so the queue stays as it was.
+func (q *PersistentQueue) Push(value Item) error {
+	if q.failed != nil {
+		return q.failed
+	}
+
+	data, err := q.codec.Encode(value)
+	if err != nil {
+		return err
+	}
+
+	last := q.segments[len(q.segments)-1]
+	if last.size >= q.options.SegmentSize {
+		if err := q.rotate(); err != nil {
+			return err
+		}
+		// the head segment may have been fully popped while it was the last one. If it can't
+		// be deleted now, the next Peek or Pop retries it.
+		q.compact()
+		last = q.segments[len(q.segments)-1]
+	}
+
+	record := make([]byte, recordHeaderSize+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	binary.BigEndian.PutUint32(record[4:], crc32.ChecksumIEEE(data))
+	copy(record[recordHeaderSize:], data)
+	if _, err := q.writer.Write(record); err != nil {
+		return q.discardWrite(last, err)
+	}
+	if q.options.Sync {
+		if err := q.writer.Sync(); err != nil {
+			return q.discardWrite(last, err)
+		}
+	}
+
+	last.size += int64(len(record))
+	last.count++
+	q.tail++
+	return nil
+}
+
+// discardWrite truncates the last segment back to its known size, dropping the bytes of a
+// failed push, and returns err. If the truncation fails the queue can't be written anymore.
+func (q *PersistentQueue) discardWrite(last *segment, err error) error {
+	if terr := q.writer.Truncate(last.size); terr != nil {
+		q.failed = fmt.Errorf("gostrutures: can't discard a failed push: %w", terr)
+		return errors.Join(err, q.failed)
+	}
+	return err
+}
+
+// Pop retrieves and removes the head of this queue. Return ErrQueueEmpty if this queue is empty.
+func (q *PersistentQueue) Pop() (Item, error) {
+	item, size, err := q.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.writeCommit(q.head + 1); err != nil {
+		return nil, err
+	}
+	q.head++
+	q.headOffset += size
+	// the item is already popped, if the consumed segments can't be deleted now the next
+	// Peek or Pop retries it
+	q.compact()
+	return item, nil
+}
+
+// Peek returns but does not remove, the head of this queue. Return ErrQueueEmpty if this queue is empty.
+func (q *PersistentQueue) Peek() (Item, error) {
+	item, _, err := q.peek()
+	return item, err
+}
+
+// Size returns the number of Items in the queue
+func (q *PersistentQueue) Size() int {
+	return int(q.tail - q.head)
+}
+
+// IsEmpty returns true if the queue is empty
+func (q *PersistentQueue) IsEmpty() bool {
+	return q.tail == q.head
+}
+
+// Close closes the files of the queue.
+func (q *PersistentQueue) Close() error {
+	var err error
+	for _, f := range []*os.File{q.reader, q.writer} {
+		if f != nil {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}
+	}
+	q.reader, q.writer = nil, nil
+	return err
+}
+
+// peek decodes the head record and returns it with its size into the segment.
+func (q *PersistentQueue) peek() (Item, int64, error) {
+	if q.IsEmpty() {
+		return nil, 0, ErrQueueEmpty
+	}
+	if err := q.compact(); err != nil {
+		return nil, 0, err
+	}
+
+	data, err := readRecord(q.reader, q.headOffset, q.segments[0].size)
+	if err != nil {
+		return nil, 0, err
+	}
+	item, err := q.codec.Decode(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	return item, int64(recordHeaderSize + len(data)), nil
+}
+
+// recover loads the segments and the commit offset from the directory.
+func (q *PersistentQueue) recover() error {
+	segments, err := q.listSegments()
+	if err != nil {
+		return err
+	}
+
+	for i, s := range segments {
+		if err := q.scanSegment(s, i == len(segments)-1); err != nil {
+			return err
+		}
+		if i > 0 && segments[i-1].first+segments[i-1].count != s.first {
+			return fmt.Errorf("%w: missing records before segment %s", ErrCorruptedQueue, s.name())
+		}
+	}
+
+	if q.head, err = q.readCommit(); err != nil {
+		return err
+	}
+
+	if len(segments) == 0 {
+		segments = append(segments, &segment{first: q.head})
+	}
+	q.segments = segments
+	last := segments[len(segments)-1]
+	q.tail = last.first + last.count
+	if q.head < segments[0].first || q.head > q.tail {
+		return fmt.Errorf("%w: commit offset %d out of the records range", ErrCorruptedQueue, q.head)
+	}
+
+	if q.writer, err = os.OpenFile(q.path(last), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644); err != nil {
+		return err
+	}
+	if err := q.openReader(); err != nil {
+		return err
+	}
+	if err := q.compact(); err != nil {
+		return err
+	}
+
+	// skip the records of the first segment popped before the head
+	for i := q.segments[0].first; i < q.head; i++ {
+		data, err := readRecord(q.reader, q.headOffset, q.segments[0].size)
+		if err != nil {
+			return err
+		}
+		q.headOffset += int64(recordHeaderSize + len(data))
+	}
+	return nil
+}
+
+func (q *PersistentQueue) listSegments() ([]*segment, error) {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []*segment
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		first, err := strconv.ParseInt(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, &segment{first: first})
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].first < segments[j].first })
+	return segments, nil
+}
+
+// scanSegment counts the valid records of the segment. A partially written record at the end
+// of the last segment is truncated, as it's the result of an interrupted push.
+func (q *PersistentQueue) scanSegment(s *segment, last bool) error {
+	f, err := os.Open(q.path(s))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	for s.size < info.Size() {
+		data, err := readRecord(f, s.size, info.Size())
+		if err != nil {
+			if !last {
+				return fmt.Errorf("%w: invalid record in segment %s: %v", ErrCorruptedQueue, s.name(), err)
+			}
+			return os.Truncate(q.path(s), s.size)
+		}
+		s.size += int64(recordHeaderSize + len(data))
+		s.count++
+	}
+	return nil
+}
+
+// compact deletes the first segments whose records were all popped, keeping the last one.
+// If a segment can't be deleted the reader is kept open, so a later call can retry it.
+func (q *PersistentQueue) compact() error {
+	for len(q.segments) > 1 && q.head >= q.segments[0].first+q.segments[0].count {
+		if q.reader != nil {
+			if err := q.reader.Close(); err != nil {
+				return err
+			}
+			q.reader = nil
+		}
+		if err := os.Remove(q.path(q.segments[0])); err != nil {
+			q.openReader()
+			return err
+		}
+		q.segments = q.segments[1:]
+		q.headOffset = 0
+	}
+
+	if q.reader == nil {
+		return q.openReader()
+	}
+	return nil
+}
+
+// rotate starts a new segment for the next pushes.
+func (q *PersistentQueue) rotate() error {
+	s := &segment{first: q.tail}
+	writer, err := os.OpenFile(q.path(s), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := q.writer.Close(); err != nil {
+		writer.Close()
+		return err
+	}
+	q.writer = writer
+	q.segments = append(q.segments, s)
+	return nil
+}
+
+func (q *PersistentQueue) openReader() error {
+	var err error
+	q.reader, err = os.Open(q.path(q.segments[0]))
+	return err
+}
+
+func (q *PersistentQueue) path(s *segment) string {
+	return filepath.Join(q.dir, s.name())
+}
+
+func (q *PersistentQueue) readCommit() (int64, error) {
+	data, err := os.ReadFile(filepath.Join(q.dir, commitFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data) != 12 || crc32.ChecksumIEEE(data[:8]) != binary.BigEndian.Uint32(data[8:]) {
+		return 0, fmt.Errorf("%w: invalid commit file", ErrCorruptedQueue)
+	}
+	return int64(binary.BigEndian.Uint64(data)), nil
+}
+
+// writeCommit persists the head offset, replacing atomically the commit file.
+func (q *PersistentQueue) writeCommit(head int64) error {
+	data := make([]byte, 12)
+	binary.BigEndian.PutUint64(data, uint64(head))
+	binary.BigEndian.PutUint32(data[8:], crc32.ChecksumIEEE(data[:8]))
+
+	tmp := filepath.Join(q.dir, commitFile+".tmp")
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if q.options.Sync {
+		if err := f.Sync(); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(q.dir, commitFile))
+}
+
+// readRecord reads the record at offset, checking that it fits before end and its checksum.
+func readRecord(r io.ReaderAt, offset, end int64) ([]byte, error) {
+	header := make([]byte, recordHeaderSize)
+	if _, err := r.ReadAt(header, offset); err != nil {
+		return nil, err
+	}
+
+	length := int64(binary.BigEndian.Uint32(header))
+	if offset+recordHeaderSize+length > end {
+		return nil, io.ErrUnexpectedEOF
+	}
+	data := make([]byte, length)
+	if _, err := r.ReadAt(data, offset+recordHeaderSize); err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(header[4:]) {
+		return nil, errors.New("checksum mismatch")
+	}
+	return data, nil
+}
@@ -0,0 +1,261 @@
+package gostrutures_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func openPersistentQueue(t *testing.T, dir string, options *gostrutures.PersistentQueueOptions) *gostrutures.PersistentQueue {
+	q, err := gostrutures.OpenPersistentQueue(dir, gostrutures.StringCodec{}, options)
+	if err != nil {
+		t.Fatalf("Expected open error to be nil. Got '%v'", err)
+	}
+	return q
+}
+
+func segmentFiles(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, "*.seg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestPersistentQueuePushPop(t *testing.T) {
+	q := openPersistentQueue(t, t.TempDir(), nil)
+	defer q.Close()
+
+	for _, v := range []string{"a", "b", "", "c"} {
+		if err := q.Push(v); err != nil {
+			t.Fatalf("Expected push error to be nil. Got '%v'", err)
+		}
+	}
+
+	if q.Size() != 4 {
+		t.Errorf("Expected queue size to be '%v'. Got '%v'", 4, q.Size())
+	}
+
+	if item, err := q.Peek(); item != "a" || err != nil {
+		t.Errorf("Expected peek to be 'a, nil'. Got '%v, %v'", item, err)
+	}
+
+	for _, expected := range []string{"a", "b", "", "c"} {
+		if item, err := q.Pop(); item != expected || err != nil {
+			t.Fatalf("Expected pop to be '%v, nil'. Got '%v, %v'", expected, item, err)
+		}
+	}
+
+	if _, err := q.Pop(); err != gostrutures.ErrQueueEmpty {
+		t.Errorf("Expected pop error to be '%v'. Got '%v'", gostrutures.ErrQueueEmpty, err)
+	}
+
+	if _, err := q.Peek(); err != gostrutures.ErrQueueEmpty {
+		t.Errorf("Expected peek error to be '%v'. Got '%v'", gostrutures.ErrQueueEmpty, err)
+	}
+
+	if !q.IsEmpty() {
+		t.Errorf("Expected queue IsEmpty to be '%v'. Got '%v'", true, q.IsEmpty())
+	}
+}
+
+func TestPersistentQueueRecover(t *testing.T) {
+	tt := []struct {
+		name    string
+		options *gostrutures.PersistentQueueOptions
+	}{
+		{"single segment", nil},
+		{"many segments", &gostrutures.PersistentQueueOptions{SegmentSize: 32, Sync: true}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			q := openPersistentQueue(t, dir, tc.options)
+			for i := 0; i < 10; i++ {
+				q.Push(fmt.Sprintf("item-%d", i))
+			}
+			for i := 0; i < 4; i++ {
+				q.Pop()
+			}
+			q.Close()
+
+			q = openPersistentQueue(t, dir, tc.options)
+			if q.Size() != 6 {
+				t.Fatalf("Expected recovered queue size to be '%v'. Got '%v'", 6, q.Size())
+			}
+			q.Push("item-10")
+			q.Close()
+
+			q = openPersistentQueue(t, dir, tc.options)
+			defer q.Close()
+			for i := 4; i <= 10; i++ {
+				expected := fmt.Sprintf("item-%d", i)
+				if item, err := q.Pop(); item != expected || err != nil {
+					t.Fatalf("Expected pop to be '%v, nil'. Got '%v, %v'", expected, item, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPersistentQueueCompactsConsumedSegments(t *testing.T) {
+	dir := t.TempDir()
+	q := openPersistentQueue(t, dir, &gostrutures.PersistentQueueOptions{SegmentSize: 32})
+	defer q.Close()
+
+	for i := 0; i < 20; i++ {
+		q.Push(fmt.Sprintf("item-%02d", i))
+	}
+	pushed := len(segmentFiles(t, dir))
+	if pushed < 5 {
+		t.Fatalf("Expected pushes to create many segments. Got '%v'", pushed)
+	}
+
+	for i := 0; i < 20; i++ {
+		q.Pop()
+	}
+
+	if files := segmentFiles(t, dir); len(files) != 1 {
+		t.Errorf("Expected consumed segments to be deleted. Got '%v'", files)
+	}
+}
+
+func TestPersistentQueuePushAfterDrainingFullSegment(t *testing.T) {
+	dir := t.TempDir()
+	q := openPersistentQueue(t, dir, &gostrutures.PersistentQueueOptions{SegmentSize: 16})
+	defer q.Close()
+
+	q.Push("abcdefgh")
+	q.Push("abcdefgh")
+	for i := 0; i < 2; i++ {
+		if item, err := q.Pop(); item != "abcdefgh" || err != nil {
+			t.Fatalf("Expected pop to be 'abcdefgh, nil'. Got '%v, %v'", item, err)
+		}
+	}
+
+	// the segment is full, so the push starts a new one
+	if err := q.Push("next"); err != nil {
+		t.Fatalf("Expected push error to be nil. Got '%v'", err)
+	}
+	if item, err := q.Pop(); item != "next" || err != nil {
+		t.Errorf("Expected pop to be 'next, nil'. Got '%v, %v'", item, err)
+	}
+
+	if files := segmentFiles(t, dir); len(files) != 1 {
+		t.Errorf("Expected consumed segments to be deleted. Got '%v'", files)
+	}
+}
+
+func TestPersistentQueuePopWhenCompactionFails(t *testing.T) {
+	dir := t.TempDir()
+	q := openPersistentQueue(t, dir, &gostrutures.PersistentQueueOptions{SegmentSize: 16})
+	defer q.Close()
+
+	q.Push("abcdefgh")
+	q.Push("ijklmnop")
+
+	// a non empty directory in place of the first segment can't be removed
+	first := segmentFiles(t, dir)[0]
+	if err := os.Rename(first, first+".bak"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(first, "blocker"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if item, err := q.Pop(); item != "abcdefgh" || err != nil {
+		t.Fatalf("Expected pop to be 'abcdefgh, nil'. Got '%v, %v'", item, err)
+	}
+
+	if err := os.RemoveAll(first); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(first+".bak", first); err != nil {
+		t.Fatal(err)
+	}
+
+	if item, err := q.Pop(); item != "ijklmnop" || err != nil {
+		t.Errorf("Expected pop to be 'ijklmnop, nil'. Got '%v, %v'", item, err)
+	}
+
+	if files := segmentFiles(t, dir); len(files) != 1 {
+		t.Errorf("Expected consumed segments to be deleted. Got '%v'", files)
+	}
+}
+
+func TestPersistentQueueTruncatesPartialRecord(t *testing.T) {
+	dir := t.TempDir()
+	q := openPersistentQueue(t, dir, nil)
+	q.Push("complete")
+	q.Close()
+
+	// simulate a crash in the middle of a push
+	files := segmentFiles(t, dir)
+	f, err := os.OpenFile(files[0], os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0, 0, 0, 9, 1, 2})
+	f.Close()
+
+	q = openPersistentQueue(t, dir, nil)
+	defer q.Close()
+	if q.Size() != 1 {
+		t.Fatalf("Expected recovered queue size to be '%v'. Got '%v'", 1, q.Size())
+	}
+
+	q.Push("next")
+	for _, expected := range []string{"complete", "next"} {
+		if item, err := q.Pop(); item != expected || err != nil {
+			t.Fatalf("Expected pop to be '%v, nil'. Got '%v, %v'", expected, item, err)
+		}
+	}
+}
+
+func TestPersistentQueueCorruptedCommit(t *testing.T) {
+	dir := t.TempDir()
+	q := openPersistentQueue(t, dir, nil)
+	q.Push("a")
+	q.Pop()
+	q.Close()
+
+	os.WriteFile(filepath.Join(dir, "commit"), []byte("garbage"), 0o644)
+	if _, err := gostrutures.OpenPersistentQueue(dir, gostrutures.StringCodec{}, nil); !errors.Is(err, gostrutures.ErrCorruptedQueue) {
+		t.Errorf("Expected open error to be '%v'. Got '%v'", gostrutures.ErrCorruptedQueue, err)
+	}
+}
+
+func TestPersistentQueueCodecError(t *testing.T) {
+	q := openPersistentQueue(t, t.TempDir(), nil)
+	defer q.Close()
+
+	if err := q.Push(1); err == nil {
+		t.Error("Expected push of a value the codec can't encode to fail")
+	}
+
+	if q.Size() != 0 {
+		t.Errorf("Expected queue size to be '%v'. Got '%v'", 0, q.Size())
+	}
+}
+
+func TestBytesCodec(t *testing.T) {
+	codec := gostrutures.BytesCodec{}
+	data, err := codec.Encode([]byte("abc"))
+	if err != nil {
+		t.Fatalf("Expected encode error to be nil. Got '%v'", err)
+	}
+
+	item, err := codec.Decode(data)
+	if err != nil || string(item.([]byte)) != "abc" {
+		t.Errorf("Expected decode to be 'abc, nil'. Got '%v, %v'", item, err)
+	}
+
+	if _, err := codec.Encode("abc"); err == nil {
+		t.Error("Expected encode of a string to fail")
+	}
+}
@@ -0,0 +1,163 @@
+package gostrutures
+
+import (
+	"container/heap"
+)
+
+// MinPriority orders a PriorityQueue as a min-heap, serving first the lowest priority.
+func MinPriority(a, b int) bool {
+	return a < b
+}
+
+// MaxPriority orders a PriorityQueue as a max-heap, serving first the highest priority.
+func MaxPriority(a, b int) bool {
+	return a > b
+}
+
+// PriorityHandle references an element pushed into a PriorityQueue, allowing to update
+// its priority or remove it.
+type PriorityHandle struct {
+	item     Item
+	priority int
+	// index is the position into the heap or -1 when the element is not in the queue.
+	index int
+	seq   uint64
+}
+
+// Item returns the element (Item) referenced by the handle.
+func (h *PriorityHandle) Item() Item {
+	return h.item
+}
+
+// Priority returns the current priority of the element referenced by the handle.
+func (h *PriorityHandle) Priority() int {
+	return h.priority
+}
+
+// PriorityQueue is a priority queue implemented with a binary heap, where the order of the
+// priorities is given by a comparator. When the queue is stable, the elements with equal
+// priorities are served in the same order they were pushed (FIFO).
+//
+// Read/Write operations are not safe for concurrent mutation by multiple goroutines.
+type PriorityQueue struct {
+	entries priorityEntries
+}
+
+// NewPriorityQueue build an empty PriorityQueue where less reports whether the priority a
+// must be served before b, e.g. MinPriority or MaxPriority.
+func NewPriorityQueue(less func(a, b int) bool) *PriorityQueue {
+	return &PriorityQueue{priorityEntries{less: less}}
+}
+
+// NewStablePriorityQueue build an empty PriorityQueue that serves the elements with equal
+// priorities in FIFO order.
+func NewStablePriorityQueue(less func(a, b int) bool) *PriorityQueue {
+	return &PriorityQueue{priorityEntries{less: less, stable: true}}
+}
+
+// Push adds an element (Item) with the given priority and returns its handle.
+func (q *PriorityQueue) Push(value Item, priority int) *PriorityHandle {
+	h := &PriorityHandle{item: value, priority: priority, seq: q.entries.seq}
+	q.entries.seq++
+	heap.Push(&q.entries, h)
+	return h
+}
+
+// Pop retrieves and removes the element with the first priority. Return false if the queue is empty.
+func (q *PriorityQueue) Pop() (Item, bool) {
+	if len(q.entries.handles) == 0 {
+		return nil, false
+	}
+	return heap.Pop(&q.entries).(*PriorityHandle).item, true
+}
+
+// Peek returns but does not remove, the element with the first priority. Return false if the queue is empty.
+func (q *PriorityQueue) Peek() (Item, bool) {
+	h := q.PeekHandle()
+	if h == nil {
+		return nil, false
+	}
+	return h.item, true
+}
+
+// PeekHandle returns the handle of the element with the first priority, or nil if the queue is empty.
+func (q *PriorityQueue) PeekHandle() *PriorityHandle {
+	if len(q.entries.handles) == 0 {
+		return nil
+	}
+	return q.entries.handles[0]
+}
+
+// Update changes the priority of the element referenced by the handle. Return false if the
+// element is no longer in the queue.
+func (q *PriorityQueue) Update(h *PriorityHandle, priority int) bool {
+	if !q.contains(h) {
+		return false
+	}
+
+	h.priority = priority
+	heap.Fix(&q.entries, h.index)
+	return true
+}
+
+// Remove removes the element referenced by the handle. Return false if the element is no
+// longer in the queue.
+func (q *PriorityQueue) Remove(h *PriorityHandle) bool {
+	if !q.contains(h) {
+		return false
+	}
+
+	heap.Remove(&q.entries, h.index)
+	return true
+}
+
+// Len returns the number of Items in the queue.
+func (q *PriorityQueue) Len() int {
+	return len(q.entries.handles)
+}
+
+func (q *PriorityQueue) contains(h *PriorityHandle) bool {
+	return h != nil && h.index >= 0 && h.index < len(q.entries.handles) && q.entries.handles[h.index] == h
+}
+
+// priorityEntries implements heap.Interface over the handles.
+type priorityEntries struct {
+	handles []*PriorityHandle
+	less    func(a, b int) bool
+	stable  bool
+	// seq is the insertion sequence number for the next pushed element.
+	seq uint64
+}
+
+func (e priorityEntries) Len() int {
+	return len(e.handles)
+}
+
+func (e priorityEntries) Less(i, j int) bool {
+	a, b := e.handles[i], e.handles[j]
+	if e.stable && !e.less(a.priority, b.priority) && !e.less(b.priority, a.priority) {
+		return a.seq < b.seq
+	}
+	return e.less(a.priority, b.priority)
+}
+
+func (e priorityEntries) Swap(i, j int) {
+	e.handles[i], e.handles[j] = e.handles[j], e.handles[i]
+	e.handles[i].index = i
+	e.handles[j].index = j
+}
+
+func (e *priorityEntries) Push(x interface{}) {
+	h := x.(*PriorityHandle)
+	h.index = len(e.handles)
+	e.handles = append(e.handles, h)
+}
+
+func (e *priorityEntries) Pop() interface{} {
+	last := len(e.handles) - 1
+	h := e.handles[last]
+	e.handles[last] = nil
+	e.handles = e.handles[:last]
+	h.index = -1
+	return h
+}
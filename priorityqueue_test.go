@@ -0,0 +1,136 @@
+package gostrutures_test
+
+import (
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+type prioritized struct {
+	item     string
+	priority int
+}
+
+func popAll(q *gostrutures.PriorityQueue) []gostrutures.Item {
+	var result []gostrutures.Item
+	for item, ok := q.Pop(); ok; item, ok = q.Pop() {
+		result = append(result, item)
+	}
+	return result
+}
+
+func checkPopOrder(t *testing.T, q *gostrutures.PriorityQueue, expected []string) {
+	result := popAll(q)
+	if len(result) != len(expected) {
+		t.Fatalf("Expected pop order to be '%v'. Got '%v'", expected, result)
+	}
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Fatalf("Expected pop order to be '%v'. Got '%v'", expected, result)
+		}
+	}
+}
+
+func TestPriorityQueuePop(t *testing.T) {
+	values := []prioritized{{"c", 3}, {"a", 1}, {"e", 5}, {"b", 2}, {"d", 4}}
+
+	tt := []struct {
+		name     string
+		queue    *gostrutures.PriorityQueue
+		expected []string
+	}{
+		{"min heap", gostrutures.NewPriorityQueue(gostrutures.MinPriority), []string{"a", "b", "c", "d", "e"}},
+		{"max heap", gostrutures.NewPriorityQueue(gostrutures.MaxPriority), []string{"e", "d", "c", "b", "a"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, v := range values {
+				tc.queue.Push(v.item, v.priority)
+			}
+
+			if tc.queue.Len() != len(values) {
+				t.Errorf("Expected queue len to be '%v'. Got '%v'", len(values), tc.queue.Len())
+			}
+
+			if result, ok := tc.queue.Peek(); result != tc.expected[0] || !ok {
+				t.Errorf("Expected peek to be '%v, true'. Got '%v, %v'", tc.expected[0], result, ok)
+			}
+
+			checkPopOrder(t, tc.queue, tc.expected)
+		})
+	}
+}
+
+func TestPriorityQueueStable(t *testing.T) {
+	q := gostrutures.NewStablePriorityQueue(gostrutures.MinPriority)
+	values := []prioritized{
+		{"a1", 1}, {"b1", 2}, {"a2", 1}, {"b2", 2}, {"a3", 1}, {"b3", 2}, {"a4", 1}, {"a5", 1},
+	}
+	for _, v := range values {
+		q.Push(v.item, v.priority)
+	}
+
+	checkPopOrder(t, q, []string{"a1", "a2", "a3", "a4", "a5", "b1", "b2", "b3"})
+}
+
+func TestPriorityQueueEmpty(t *testing.T) {
+	q := gostrutures.NewPriorityQueue(gostrutures.MinPriority)
+
+	if result, ok := q.Pop(); result != nil || ok {
+		t.Errorf("Expected pop to be 'nil, false'. Got '%v, %v'", result, ok)
+	}
+
+	if result, ok := q.Peek(); result != nil || ok {
+		t.Errorf("Expected peek to be 'nil, false'. Got '%v, %v'", result, ok)
+	}
+
+	if h := q.PeekHandle(); h != nil {
+		t.Errorf("Expected peek handle to be nil. Got '%v'", h)
+	}
+}
+
+func TestPriorityQueueUpdate(t *testing.T) {
+	q := gostrutures.NewPriorityQueue(gostrutures.MinPriority)
+	q.Push("a", 1)
+	b := q.Push("b", 2)
+	c := q.Push("c", 3)
+
+	if !q.Update(c, 0) {
+		t.Fatal("Expected update to be true")
+	}
+
+	if c.Priority() != 0 || c.Item() != "c" {
+		t.Errorf("Expected handle to be 'c, 0'. Got '%v, %v'", c.Item(), c.Priority())
+	}
+
+	q.Update(b, 5)
+	checkPopOrder(t, q, []string{"c", "a", "b"})
+
+	if q.Update(c, 1) {
+		t.Error("Expected update of a popped element to be false")
+	}
+}
+
+func TestPriorityQueueRemove(t *testing.T) {
+	q := gostrutures.NewPriorityQueue(gostrutures.MinPriority)
+	handles := make(map[string]*gostrutures.PriorityHandle)
+	for _, v := range []prioritized{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}, {"e", 5}} {
+		handles[v.item] = q.Push(v.item, v.priority)
+	}
+
+	if !q.Remove(handles["c"]) || !q.Remove(handles["a"]) {
+		t.Fatal("Expected remove to be true")
+	}
+
+	if q.Remove(handles["a"]) {
+		t.Error("Expected remove of a removed element to be false")
+	}
+
+	other := gostrutures.NewPriorityQueue(gostrutures.MinPriority)
+	if other.Remove(handles["b"]) || other.Remove(nil) {
+		t.Error("Expected remove of an element from another queue to be false")
+	}
+
+	checkPopOrder(t, q, []string{"b", "d", "e"})
+}
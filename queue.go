@@ -12,24 +12,39 @@ func (q *Queue) Push(value Item) {
 	*q = append(*q, value)
 }
 
-//Pop retrieves and removes the head of this queue, or returns nil if this queue is empty.
+// Pop retrieves and removes the head of this queue, or returns nil if this queue is empty.
+// Use TryPop to tell apart an empty queue from a nil Item.
 func (q *Queue) Pop() Item {
-	item := q.Peek()
-	if item != nil {
-		*q = (*q)[1:q.Size()]
-	}
+	item, _ := q.TryPop()
 	return item
 }
 
+// TryPop retrieves and removes the head of this queue. Return false if this queue is empty.
+func (q *Queue) TryPop() (Item, bool) {
+	item, ok := q.TryPeek()
+	if ok {
+		// release the reference so the item can be garbage collected
+		(*q)[0] = nil
+		*q = (*q)[1:]
+	}
+	return item, ok
+}
+
 // Peek returns but does not remove, the head of this queue, or returns nil if this queue is empty.
+// Use TryPeek to tell apart an empty queue from a nil Item.
 func (q *Queue) Peek() Item {
-	var item Item
-	if q.Size() > 0 {
-		item = (*q)[0]
-	}
+	item, _ := q.TryPeek()
 	return item
 }
 
+// TryPeek returns but does not remove, the head of this queue. Return false if this queue is empty.
+func (q *Queue) TryPeek() (Item, bool) {
+	if q.Size() == 0 {
+		return nil, false
+	}
+	return (*q)[0], true
+}
+
 // Size returns the number of Items in the queue
 func (q *Queue) Size() int {
 	return len(*q)
@@ -39,3 +54,57 @@ func (q *Queue) Size() int {
 func (q *Queue) IsEmpty() bool {
 	return len(*q) == 0
 }
+
+// PushAll adds the elements (Items) to the end of the queue in order. It allocates at most
+// once, growing the queue to fit all the elements.
+func (q *Queue) PushAll(values ...Item) {
+	if n := len(*q) + len(values); n > cap(*q) {
+		// the popped slots before the head are not reused by append, so compact while growing
+		grown := make(Queue, len(*q), max(n, 2*cap(*q)))
+		copy(grown, *q)
+		*q = grown
+	}
+	*q = append(*q, values...)
+}
+
+// PopN retrieves and removes up to n elements from the head of this queue, in order. Return
+// less than n elements if this queue has less.
+func (q *Queue) PopN(n int) []Item {
+	n = min(n, len(*q))
+	if n <= 0 {
+		return nil
+	}
+
+	items := make([]Item, n)
+	copy(items, *q)
+	// release the references so the items can be garbage collected
+	clear((*q)[:n])
+	*q = (*q)[n:]
+	return items
+}
+
+// DrainTo removes the elements from the head of this queue passing them to f, until f
+// returns false or this queue is empty. The element rejected by f stays at the head.
+// Return the number of elements removed.
+func (q *Queue) DrainTo(f func(Item) bool) int {
+	n := 0
+	for n < len(*q) && f((*q)[n]) {
+		n++
+	}
+	clear((*q)[:n])
+	*q = (*q)[n:]
+	return n
+}
+
+// Clear removes all the elements of this queue.
+func (q *Queue) Clear() {
+	clear(*q)
+	*q = (*q)[:0]
+}
+
+// Snapshot returns a copy of the elements of this queue, from head to tail.
+func (q *Queue) Snapshot() []Item {
+	items := make([]Item, len(*q))
+	copy(items, *q)
+	return items
+}
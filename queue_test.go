@@ -156,3 +156,235 @@ func TestQueueIsEmpty(t *testing.T) {
 		}
 	}
 }
+
+func TestQueueTryPop(t *testing.T) {
+	tt := []struct {
+		name          string
+		insertValues  []gostrutures.Item
+		expectedItem  gostrutures.Item
+		expectedFound bool
+	}{
+		{"empty queue", []gostrutures.Item{}, nil, false},
+		{"queue with elements", []gostrutures.Item{3, 2}, 3, true},
+		{"nil item", []gostrutures.Item{nil, 2}, nil, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := new(gostrutures.Queue)
+			for _, value := range tc.insertValues {
+				queue.Push(value)
+			}
+
+			peeked, peekFound := queue.TryPeek()
+			if peeked != tc.expectedItem || peekFound != tc.expectedFound {
+				t.Errorf("Expected try peek to be '%v, %v'. Got '%v, %v'", tc.expectedItem, tc.expectedFound, peeked, peekFound)
+			}
+
+			result, found := queue.TryPop()
+			if result != tc.expectedItem || found != tc.expectedFound {
+				t.Errorf("Expected try pop to be '%v, %v'. Got '%v, %v'", tc.expectedItem, tc.expectedFound, result, found)
+			}
+
+			expectedSize := len(tc.insertValues)
+			if found {
+				expectedSize--
+			}
+			if queue.Size() != expectedSize {
+				t.Errorf("Expected queue size after pop to be '%v'. Got '%v'", expectedSize, queue.Size())
+			}
+		})
+	}
+}
+
+func TestQueuePopNilItem(t *testing.T) {
+	// Given
+	queue := new(gostrutures.Queue)
+	queue.Push(nil)
+	queue.Push(2)
+	// When pop
+	result := queue.Pop()
+	// Then
+	if result != nil {
+		t.Errorf("Expected pop element to be nil. Got '%v'", result)
+	}
+
+	if next := queue.Pop(); next != 2 {
+		t.Errorf("Expected pop element after nil item to be '%v'. Got '%v'", 2, next)
+	}
+
+	if !queue.IsEmpty() {
+		t.Errorf("Expected queue IsEmpty to be '%v'. Got '%v'", true, queue.IsEmpty())
+	}
+}
+
+func TestQueuePushAll(t *testing.T) {
+	tt := []struct {
+		name     string
+		popped   int
+		values   []gostrutures.Item
+		expected []gostrutures.Item
+	}{
+		{"no elements", 0, nil, []gostrutures.Item{1, 2, 3}},
+		{"after pushes", 0, []gostrutures.Item{4, 5}, []gostrutures.Item{1, 2, 3, 4, 5}},
+		{"after pops", 2, []gostrutures.Item{4, 5}, []gostrutures.Item{3, 4, 5}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := new(gostrutures.Queue)
+			queue.PushAll(1, 2, 3)
+			queue.PopN(tc.popped)
+			queue.PushAll(tc.values...)
+
+			if result := queue.Snapshot(); !equalItems(result, tc.expected) {
+				t.Errorf("Expected queue items to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestQueuePushAllAllocatesOnce(t *testing.T) {
+	values := make([]gostrutures.Item, 1000)
+	for i := range values {
+		values[i] = i
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		queue := gostrutures.New()
+		queue.Push(-1)
+		queue.PushAll(values...)
+	})
+	// one allocation for the queue, one for Push and one for PushAll
+	if allocs > 3 {
+		t.Errorf("Expected PushAll to allocate at most once. Got '%v' allocations", allocs-2)
+	}
+}
+
+func TestQueuePopN(t *testing.T) {
+	tt := []struct {
+		name          string
+		n             int
+		expectedItems []gostrutures.Item
+		expectedSize  int
+	}{
+		{"none", 0, nil, 3},
+		{"negative", -1, nil, 3},
+		{"some", 2, []gostrutures.Item{1, 2}, 1},
+		{"more than size", 5, []gostrutures.Item{1, 2, 3}, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := new(gostrutures.Queue)
+			queue.PushAll(1, 2, 3)
+
+			if result := queue.PopN(tc.n); !equalItems(result, tc.expectedItems) {
+				t.Errorf("Expected pop n to be '%v'. Got '%v'", tc.expectedItems, result)
+			}
+
+			if queue.Size() != tc.expectedSize {
+				t.Errorf("Expected queue size to be '%v'. Got '%v'", tc.expectedSize, queue.Size())
+			}
+		})
+	}
+}
+
+func TestQueueDrainTo(t *testing.T) {
+	tt := []struct {
+		name          string
+		limit         int
+		expectedItems []gostrutures.Item
+		expectedRest  []gostrutures.Item
+	}{
+		{"drain all", 10, []gostrutures.Item{1, 2, 3, 4}, []gostrutures.Item{}},
+		{"stop when rejected", 2, []gostrutures.Item{1, 2}, []gostrutures.Item{3, 4}},
+		{"reject first", 0, nil, []gostrutures.Item{1, 2, 3, 4}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := new(gostrutures.Queue)
+			queue.PushAll(1, 2, 3, 4)
+
+			var drained []gostrutures.Item
+			n := queue.DrainTo(func(item gostrutures.Item) bool {
+				if len(drained) == tc.limit {
+					return false
+				}
+				drained = append(drained, item)
+				return true
+			})
+
+			if n != len(tc.expectedItems) || !equalItems(drained, tc.expectedItems) {
+				t.Errorf("Expected drained items to be '%v'. Got '%v, %v'", tc.expectedItems, n, drained)
+			}
+
+			if result := queue.Snapshot(); !equalItems(result, tc.expectedRest) {
+				t.Errorf("Expected queue items to be '%v'. Got '%v'", tc.expectedRest, result)
+			}
+		})
+	}
+}
+
+func TestQueueClear(t *testing.T) {
+	queue := new(gostrutures.Queue)
+	queue.PushAll(1, 2, 3)
+	queue.Clear()
+
+	if !queue.IsEmpty() {
+		t.Errorf("Expected queue IsEmpty to be '%v'. Got '%v'", true, queue.IsEmpty())
+	}
+
+	queue.Push(4)
+	if result := queue.Pop(); result != 4 {
+		t.Errorf("Expected pop element to be '%v'. Got '%v'", 4, result)
+	}
+}
+
+func TestQueueSnapshotIsACopy(t *testing.T) {
+	queue := new(gostrutures.Queue)
+	queue.PushAll(1, 2)
+
+	snapshot := queue.Snapshot()
+	snapshot[0] = 10
+	queue.Pop()
+
+	if snapshot[1] != 2 || queue.Peek() != 2 {
+		t.Errorf("Expected snapshot to not share the queue elements. Got '%v'", snapshot)
+	}
+}
+
+func equalItems(a, b []gostrutures.Item) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkQueuePush(b *testing.B) {
+	values := make([]gostrutures.Item, 4096)
+	for i := 0; i < b.N; i++ {
+		queue := gostrutures.New()
+		for _, v := range values {
+			queue.Push(v)
+		}
+		for !queue.IsEmpty() {
+			queue.Pop()
+		}
+	}
+}
+
+func BenchmarkQueuePushAll(b *testing.B) {
+	values := make([]gostrutures.Item, 4096)
+	for i := 0; i < b.N; i++ {
+		queue := gostrutures.New()
+		queue.PushAll(values...)
+		queue.DrainTo(func(gostrutures.Item) bool { return true })
+	}
+}
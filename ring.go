@@ -0,0 +1,116 @@
+package gostrutures
+
+// minRingCapacity is the capacity allocated by the first push into an empty ring.
+const minRingCapacity = 8
+
+// ring is a circular buffer whose capacity is always a power of two. It doubles when
+// it's full, and when shrink is enabled it's halved when less than a quarter of it is
+// in use, never going below minCapacity. The zero value is an empty ring.
+type ring[T any] struct {
+	items       []T
+	head        int
+	size        int
+	minCapacity int
+	shrink      bool
+}
+
+func newRing[T any](capacity int, shrink bool) ring[T] {
+	capacity = ringCapacity(capacity)
+	return ring[T]{items: make([]T, capacity), minCapacity: capacity, shrink: shrink}
+}
+
+// ringCapacity returns the lowest power of two greater or equal than capacity.
+func ringCapacity(capacity int) int {
+	c := minRingCapacity
+	for c < capacity {
+		c <<= 1
+	}
+	return c
+}
+
+func (r *ring[T]) push(value T) {
+	if r.size == len(r.items) {
+		r.resize(ringCapacity(2 * r.size))
+	}
+	r.items[(r.head+r.size)&(len(r.items)-1)] = value
+	r.size++
+}
+
+func (r *ring[T]) pop() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+
+	item := r.items[r.head]
+	// release the reference so the item can be garbage collected
+	r.items[r.head] = zero
+	r.head = (r.head + 1) & (len(r.items) - 1)
+	r.size--
+	r.shrinkIfLow()
+	return item, true
+}
+
+func (r *ring[T]) peek() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.items[r.head], true
+}
+
+func (r *ring[T]) pushFront(value T) {
+	if r.size == len(r.items) {
+		r.resize(ringCapacity(2 * r.size))
+	}
+	r.head = (r.head - 1) & (len(r.items) - 1)
+	r.items[r.head] = value
+	r.size++
+}
+
+func (r *ring[T]) popBack() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+
+	tail := (r.head + r.size - 1) & (len(r.items) - 1)
+	item := r.items[tail]
+	r.items[tail] = zero
+	r.size--
+	r.shrinkIfLow()
+	return item, true
+}
+
+func (r *ring[T]) peekBack() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.at(r.size - 1), true
+}
+
+// at returns the i-th item from the head. It panics if i is out of range.
+func (r *ring[T]) at(i int) T {
+	if i < 0 || i >= r.size {
+		panic("gostrutures: index out of range")
+	}
+	return r.items[(r.head+i)&(len(r.items)-1)]
+}
+
+func (r *ring[T]) shrinkIfLow() {
+	if r.shrink && len(r.items) > r.minCapacity && r.size <= len(r.items)/4 {
+		r.resize(len(r.items) / 2)
+	}
+}
+
+// resize moves the items to a new buffer of the given capacity, starting at the index 0.
+func (r *ring[T]) resize(capacity int) {
+	items := make([]T, capacity)
+	n := copy(items, r.items[r.head:])
+	if n < r.size {
+		copy(items[n:], r.items[:r.size-n])
+	}
+	r.items = items
+	r.head = 0
+}
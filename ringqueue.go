@@ -0,0 +1,67 @@
+package gostrutures
+
+// RingQueue is a FIFO queue backed by a circular buffer.
+//
+// Unlike Queue, the memory of the popped items is reused by the next pushes, so a
+// long-lived queue doesn't keep reallocating. The buffer doubles when it's full, and
+// when shrink is enabled it's halved when less than a quarter of it is in use.
+//
+// Read/Write operations are not safe for concurrent mutation by multiple goroutines.
+type RingQueue struct {
+	ring ring[Item]
+}
+
+// NewRingQueue build a RingQueue with room for at least capacity items before growing.
+func NewRingQueue(capacity int) *RingQueue {
+	return &RingQueue{newRing[Item](capacity, false)}
+}
+
+// NewShrinkingRingQueue build a RingQueue that releases memory when its occupancy is low,
+// never going below capacity.
+func NewShrinkingRingQueue(capacity int) *RingQueue {
+	return &RingQueue{newRing[Item](capacity, true)}
+}
+
+// Push adds an element (Item) to the end of the queue.
+func (q *RingQueue) Push(value Item) {
+	q.ring.push(value)
+}
+
+// Pop retrieves and removes the head of this queue, or returns nil if this queue is empty.
+// Use TryPop to tell apart an empty queue from a nil Item.
+func (q *RingQueue) Pop() Item {
+	item, _ := q.TryPop()
+	return item
+}
+
+// TryPop retrieves and removes the head of this queue. Return false if this queue is empty.
+func (q *RingQueue) TryPop() (Item, bool) {
+	return q.ring.pop()
+}
+
+// Peek returns but does not remove, the head of this queue, or returns nil if this queue is empty.
+// Use TryPeek to tell apart an empty queue from a nil Item.
+func (q *RingQueue) Peek() Item {
+	item, _ := q.TryPeek()
+	return item
+}
+
+// TryPeek returns but does not remove, the head of this queue. Return false if this queue is empty.
+func (q *RingQueue) TryPeek() (Item, bool) {
+	return q.ring.peek()
+}
+
+// Size returns the number of Items in the queue
+func (q *RingQueue) Size() int {
+	return q.ring.size
+}
+
+// IsEmpty returns true if the queue is empty
+func (q *RingQueue) IsEmpty() bool {
+	return q.ring.size == 0
+}
+
+// Cap returns the number of Items the queue can hold before growing.
+func (q *RingQueue) Cap() int {
+	return len(q.ring.items)
+}
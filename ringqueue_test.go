@@ -0,0 +1,220 @@
+package gostrutures_test
+
+import (
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func TestNewRingQueue(t *testing.T) {
+	tt := []struct {
+		name     string
+		capacity int
+		expected int
+	}{
+		{"zero capacity", 0, 8},
+		{"power of two", 16, 16},
+		{"rounded to the next power of two", 17, 32},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			q := gostrutures.NewRingQueue(tc.capacity)
+			if q.Cap() != tc.expected {
+				t.Errorf("Expected queue capacity to be '%v'. Got '%v'", tc.expected, q.Cap())
+			}
+		})
+	}
+}
+
+func TestRingQueuePushPop(t *testing.T) {
+	tt := []struct {
+		name  string
+		queue *gostrutures.RingQueue
+	}{
+		{"zero value", new(gostrutures.RingQueue)},
+		{"ring queue", gostrutures.NewRingQueue(0)},
+		{"shrinking ring queue", gostrutures.NewShrinkingRingQueue(0)},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := tc.queue
+			next, expected := 0, 0
+			// interleave pushes and pops so the buffer wraps around and grows while wrapped
+			for round := 1; round <= 50; round++ {
+				for i := 0; i < round; i++ {
+					queue.Push(next)
+					next++
+				}
+				for i := 0; i < round/2; i++ {
+					if result := queue.Pop(); result != expected {
+						t.Fatalf("Expected pop element to be '%v'. Got '%v'", expected, result)
+					}
+					expected++
+				}
+			}
+
+			if queue.Size() != next-expected {
+				t.Fatalf("Expected queue size to be '%v'. Got '%v'", next-expected, queue.Size())
+			}
+
+			for !queue.IsEmpty() {
+				if result := queue.Pop(); result != expected {
+					t.Fatalf("Expected pop element to be '%v'. Got '%v'", expected, result)
+				}
+				expected++
+			}
+
+			if expected != next {
+				t.Errorf("Expected to pop '%v' elements. Got '%v'", next, expected)
+			}
+		})
+	}
+}
+
+func TestRingQueuePeek(t *testing.T) {
+	// GIVEN
+	queue := gostrutures.NewRingQueue(0)
+	insertValues, peekElement := []int{3, 2, 5, 4}, 3
+	for _, value := range insertValues {
+		queue.Push(value)
+	}
+	// When peek
+	result := queue.Peek()
+	// Then
+	if result != peekElement {
+		t.Errorf("Expected peek element to be '%v'. Got '%v'", peekElement, result)
+	}
+
+	if queue.Size() != len(insertValues) {
+		t.Errorf("Expected queue size after peek to be '%v'. Got '%v'", len(insertValues), queue.Size())
+	}
+}
+
+func TestRingQueueEmpty(t *testing.T) {
+	queue := gostrutures.NewRingQueue(0)
+
+	if result := queue.Pop(); result != nil {
+		t.Errorf("Expected pop element to be nil. Got '%v'", result)
+	}
+
+	if result := queue.Peek(); result != nil {
+		t.Errorf("Expected peek element to be nil. Got '%v'", result)
+	}
+
+	if !queue.IsEmpty() {
+		t.Errorf("Expected queue IsEmpty to be '%v'. Got '%v'", true, queue.IsEmpty())
+	}
+}
+
+func TestRingQueueReusesMemory(t *testing.T) {
+	queue := gostrutures.NewRingQueue(0)
+	for i := 0; i < 10000; i++ {
+		queue.Push(i)
+		queue.Pop()
+	}
+
+	if queue.Cap() != 8 {
+		t.Errorf("Expected queue capacity to be '%v'. Got '%v'", 8, queue.Cap())
+	}
+}
+
+func TestRingQueueShrink(t *testing.T) {
+	tt := []struct {
+		name     string
+		queue    *gostrutures.RingQueue
+		expected int
+	}{
+		{"without shrink", gostrutures.NewRingQueue(16), 1024},
+		{"with shrink", gostrutures.NewShrinkingRingQueue(16), 16},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 1000; i++ {
+				tc.queue.Push(i)
+			}
+			for i := 0; i < 1000; i++ {
+				tc.queue.Pop()
+			}
+
+			if tc.queue.Cap() != tc.expected {
+				t.Errorf("Expected queue capacity to be '%v'. Got '%v'", tc.expected, tc.queue.Cap())
+			}
+		})
+	}
+}
+
+func TestRingQueueTryPop(t *testing.T) {
+	queue := gostrutures.NewRingQueue(0)
+	queue.Push(nil)
+	queue.Push(2)
+
+	for _, expected := range []gostrutures.Item{nil, 2} {
+		if result, found := queue.TryPeek(); result != expected || !found {
+			t.Errorf("Expected try peek to be '%v, true'. Got '%v, %v'", expected, result, found)
+		}
+
+		if result, found := queue.TryPop(); result != expected || !found {
+			t.Errorf("Expected try pop to be '%v, true'. Got '%v, %v'", expected, result, found)
+		}
+	}
+
+	if result, found := queue.TryPeek(); result != nil || found {
+		t.Errorf("Expected try peek to be 'nil, false'. Got '%v, %v'", result, found)
+	}
+
+	if result, found := queue.TryPop(); result != nil || found {
+		t.Errorf("Expected try pop to be 'nil, false'. Got '%v, %v'", result, found)
+	}
+}
+
+type benchQueue interface {
+	Push(gostrutures.Item)
+	Pop() gostrutures.Item
+}
+
+func benchmarkSteadyState(b *testing.B, queue benchQueue) {
+	for i := 0; i < 1000; i++ {
+		queue.Push(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		queue.Push(i)
+		queue.Pop()
+	}
+}
+
+func benchmarkBurst(b *testing.B, queue benchQueue) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 1000; j++ {
+			queue.Push(j)
+		}
+		for j := 0; j < 1000; j++ {
+			queue.Pop()
+		}
+	}
+}
+
+func BenchmarkQueueSteadyState(b *testing.B) {
+	benchmarkSteadyState(b, gostrutures.New())
+}
+
+func BenchmarkRingQueueSteadyState(b *testing.B) {
+	benchmarkSteadyState(b, gostrutures.NewRingQueue(0))
+}
+
+func BenchmarkQueueBurst(b *testing.B) {
+	benchmarkBurst(b, gostrutures.New())
+}
+
+func BenchmarkRingQueueBurst(b *testing.B) {
+	benchmarkBurst(b, gostrutures.NewRingQueue(0))
+}
+
+func BenchmarkShrinkingRingQueueBurst(b *testing.B) {
+	benchmarkBurst(b, gostrutures.NewShrinkingRingQueue(0))
+}
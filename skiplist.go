@@ -0,0 +1,215 @@
+package gostrutures
+
+import (
+	"math/rand"
+	"time"
+)
+
+const (
+	// DefaultSkipListMaxLevel is the max level used when none is given, enough for 2^32 values
+	// with the default probability.
+	DefaultSkipListMaxLevel = 16
+	// DefaultSkipListProbability is the probability used when none is given.
+	DefaultSkipListProbability = 0.25
+)
+
+// SkipListNode is a single value of a SkipList.
+type SkipListNode struct {
+	Value int
+	// next holds the following node at every level the node is in.
+	next []*SkipListNode
+}
+
+// Next returns the node with the following value, or nil if it's the last one.
+func (n *SkipListNode) Next() *SkipListNode {
+	return n.next[0]
+}
+
+// SkipListOptions configures a SkipList. Every field is optional.
+type SkipListOptions struct {
+	// MaxLevel is the maximum number of levels of a node, DefaultSkipListMaxLevel if zero.
+	MaxLevel int
+	// Probability is the probability of a node to be promoted to the next level,
+	// DefaultSkipListProbability if zero.
+	Probability float64
+	// Rand is the source of the levels. If nil a source seeded with the current time is used,
+	// pass a seeded one to get the same structure in every run.
+	Rand *rand.Rand
+}
+
+// SkipList is an ordered set of ints with the same API as binarytrees.BST, kept balanced
+// in probability by promoting every node to the upper levels at random, so the operations
+// take O(log n) expected time regardless of the insertion order.
+//
+// Read/Write operations are not safe for concurrent mutation by multiple
+// goroutines.
+type SkipList struct {
+	// head is a sentinel whose next nodes are the first ones of every level.
+	head        *SkipListNode
+	level       int
+	length      int
+	probability float64
+	rand        *rand.Rand
+	// update is reused by Insert and Remove to hold the predecessors of a value at every level.
+	update []*SkipListNode
+}
+
+// NewSkipList build an empty SkipList. If options is nil the defaults are used. It panics if
+// MaxLevel is negative or Probability is not between 0 and 1.
+func NewSkipList(options *SkipListOptions) *SkipList {
+	if options == nil {
+		options = &SkipListOptions{}
+	}
+
+	maxLevel, probability, r := options.MaxLevel, options.Probability, options.Rand
+	if maxLevel == 0 {
+		maxLevel = DefaultSkipListMaxLevel
+	}
+	if probability == 0 {
+		probability = DefaultSkipListProbability
+	}
+	if maxLevel < 0 || probability < 0 || probability >= 1 {
+		panic("gostrutures: SkipList max level must be positive and probability between 0 and 1")
+	}
+	if r == nil {
+		r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return &SkipList{
+		head:        &SkipListNode{next: make([]*SkipListNode, maxLevel)},
+		level:       1,
+		probability: probability,
+		rand:        r,
+		update:      make([]*SkipListNode, maxLevel),
+	}
+}
+
+// randomLevel returns the level of a new node, 1 plus one more with the promotion probability
+// each time, up to the max level.
+func (l *SkipList) randomLevel() int {
+	level := 1
+	for level < len(l.head.next) && l.rand.Float64() < l.probability {
+		level++
+	}
+	return level
+}
+
+// findPredecessors fills update with the last node lower than value at every level and
+// returns the node following it at the bottom level.
+func (l *SkipList) findPredecessors(value int) *SkipListNode {
+	node := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && node.next[i].Value < value {
+			node = node.next[i]
+		}
+		l.update[i] = node
+	}
+	return node.next[0]
+}
+
+// Insert insert an item in the right position in the list. Return true if the value was inserted and false otherwise
+func (l *SkipList) Insert(value int) bool {
+	if next := l.findPredecessors(value); next != nil && next.Value == value {
+		return false
+	}
+
+	level := l.randomLevel()
+	for ; l.level < level; l.level++ {
+		l.update[l.level] = l.head
+	}
+
+	node := &SkipListNode{Value: value, next: make([]*SkipListNode, level)}
+	for i := 0; i < level; i++ {
+		node.next[i] = l.update[i].next[i]
+		l.update[i].next[i] = node
+	}
+	l.length++
+	return true
+}
+
+// Remove remove an item from the list. Return true if the value was removed and false otherwise.
+func (l *SkipList) Remove(value int) bool {
+	node := l.findPredecessors(value)
+	if node == nil || node.Value != value {
+		return false
+	}
+
+	for i := range node.next {
+		l.update[i].next[i] = node.next[i]
+	}
+	for l.level > 1 && l.head.next[l.level-1] == nil {
+		l.level--
+	}
+	l.length--
+	return true
+}
+
+// Search returns the node if the value exists in the list
+func (l *SkipList) Search(value int) *SkipListNode {
+	if node := l.lowerBound(value); node != nil && node.Value == value {
+		return node
+	}
+	return nil
+}
+
+// lowerBound returns the node with the lowest value greater or equal than value.
+func (l *SkipList) lowerBound(value int) *SkipListNode {
+	node := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && node.next[i].Value < value {
+			node = node.next[i]
+		}
+	}
+	return node.next[0]
+}
+
+// Has returns true if if the value exists in the list
+func (l *SkipList) Has(value int) bool {
+	return l.Search(value) != nil
+}
+
+// Min returns the node with minimal value stored in the list
+func (l *SkipList) Min() *SkipListNode {
+	return l.head.next[0]
+}
+
+// Max returns the node with maximum value stored in the list
+func (l *SkipList) Max() *SkipListNode {
+	node := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for node.next[i] != nil {
+			node = node.next[i]
+		}
+	}
+
+	if node == l.head {
+		return nil
+	}
+	return node
+}
+
+// Len returns the number of items currently in the list.
+func (l *SkipList) Len() int {
+	return l.length
+}
+
+// Level returns the number of levels currently in use.
+func (l *SkipList) Level() int {
+	return l.level
+}
+
+// InOrderTraverse visits all the nodes in order
+func (l *SkipList) InOrderTraverse(f func(int)) {
+	for node := l.head.next[0]; node != nil; node = node.next[0] {
+		f(node.Value)
+	}
+}
+
+// Range visits in order the values between from and to, both included, until f returns false.
+func (l *SkipList) Range(from, to int, f func(int) bool) {
+	for node := l.lowerBound(from); node != nil && node.Value <= to; node = node.next[0] {
+		if !f(node.Value) {
+			return
+		}
+	}
+}
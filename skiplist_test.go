@@ -0,0 +1,250 @@
+package gostrutures_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func newSeededSkipList(seed int64) *gostrutures.SkipList {
+	return gostrutures.NewSkipList(&gostrutures.SkipListOptions{Rand: rand.New(rand.NewSource(seed))})
+}
+
+func skipListValues(l *gostrutures.SkipList) []int {
+	var values []int
+	l.InOrderTraverse(func(v int) {
+		values = append(values, v)
+	})
+	return values
+}
+
+func TestSkipListInsert(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+		expected     []int
+	}{
+		{"empty list", nil, nil},
+		{"unordered values", []int{5, 1, 9, 3, 7}, []int{1, 3, 5, 7, 9}},
+		{"duplicated values", []int{2, 2, 1, 2}, []int{1, 2}},
+		{"negative values", []int{0, -5, 5}, []int{-5, 0, 5}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newSeededSkipList(1)
+			for _, v := range tc.insertValues {
+				l.Insert(v)
+			}
+
+			if result := skipListValues(l); !equalIntSlices(result, tc.expected) {
+				t.Errorf("Expected list values to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if l.Len() != len(tc.expected) {
+				t.Errorf("Expected list len to be '%v'. Got '%v'", len(tc.expected), l.Len())
+			}
+		})
+	}
+}
+
+func TestSkipListSearch(t *testing.T) {
+	l := newSeededSkipList(1)
+	for _, v := range []int{8, 4, 12} {
+		l.Insert(v)
+	}
+
+	tt := []struct {
+		name     string
+		value    int
+		expected bool
+	}{
+		{"existing value", 4, true},
+		{"value lower than min", 1, false},
+		{"value between values", 10, false},
+		{"value greater than max", 20, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			node := l.Search(tc.value)
+			if (node != nil) != tc.expected || l.Has(tc.value) != tc.expected {
+				t.Errorf("Expected search to find the value to be '%v'. Got '%v'", tc.expected, node)
+			}
+
+			if node != nil && node.Value != tc.value {
+				t.Errorf("Expected node value to be '%v'. Got '%v'", tc.value, node.Value)
+			}
+		})
+	}
+
+	if next := l.Search(4).Next(); next.Value != 8 {
+		t.Errorf("Expected next node value to be '%v'. Got '%v'", 8, next.Value)
+	}
+}
+
+func TestSkipListRemove(t *testing.T) {
+	tt := []struct {
+		name     string
+		value    int
+		removed  bool
+		expected []int
+	}{
+		{"first value", 1, true, []int{3, 5}},
+		{"middle value", 3, true, []int{1, 5}},
+		{"last value", 5, true, []int{1, 3}},
+		{"missing value", 4, false, []int{1, 3, 5}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newSeededSkipList(1)
+			for _, v := range []int{3, 1, 5} {
+				l.Insert(v)
+			}
+
+			if result := l.Remove(tc.value); result != tc.removed {
+				t.Errorf("Expected remove to be '%v'. Got '%v'", tc.removed, result)
+			}
+
+			if result := skipListValues(l); !equalIntSlices(result, tc.expected) {
+				t.Errorf("Expected list values to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestSkipListMinMax(t *testing.T) {
+	l := newSeededSkipList(1)
+	if l.Min() != nil || l.Max() != nil {
+		t.Errorf("Expected min and max of an empty list to be nil. Got '%v, %v'", l.Min(), l.Max())
+	}
+
+	for _, v := range []int{6, -2, 10, 3} {
+		l.Insert(v)
+	}
+
+	if l.Min().Value != -2 || l.Max().Value != 10 {
+		t.Errorf("Expected min and max to be '-2, 10'. Got '%v, %v'", l.Min().Value, l.Max().Value)
+	}
+}
+
+func TestSkipListRange(t *testing.T) {
+	l := newSeededSkipList(1)
+	for v := 0; v < 20; v += 2 {
+		l.Insert(v)
+	}
+
+	tt := []struct {
+		name     string
+		from, to int
+		limit    int
+		expected []int
+	}{
+		{"bounds in the list", 4, 10, 100, []int{4, 6, 8, 10}},
+		{"bounds between values", 3, 9, 100, []int{4, 6, 8}},
+		{"bounds out of the list", -10, 100, 100, []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}},
+		{"empty range", 5, 5, 100, nil},
+		{"reversed bounds", 10, 4, 100, nil},
+		{"stop early", 0, 18, 2, []int{0, 2}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var result []int
+			l.Range(tc.from, tc.to, func(v int) bool {
+				result = append(result, v)
+				return len(result) < tc.limit
+			})
+
+			if !equalIntSlices(result, tc.expected) {
+				t.Errorf("Expected range values to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestSkipListRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	l := newSeededSkipList(1)
+	model := make(map[int]bool)
+
+	for step := 0; step < 10000; step++ {
+		v := r.Intn(500)
+		if r.Intn(3) == 0 {
+			if l.Remove(v) != model[v] {
+				t.Fatalf("Expected remove of '%v' to be '%v'", v, model[v])
+			}
+			delete(model, v)
+		} else {
+			if l.Insert(v) == model[v] {
+				t.Fatalf("Expected insert of '%v' to be '%v'", v, !model[v])
+			}
+			model[v] = true
+		}
+	}
+
+	expected := make([]int, 0, len(model))
+	for v := range model {
+		expected = append(expected, v)
+	}
+	sort.Ints(expected)
+	if result := skipListValues(l); !equalIntSlices(result, expected) || l.Len() != len(expected) {
+		t.Errorf("Expected list values to be '%v'. Got '%v'", expected, result)
+	}
+}
+
+func TestSkipListSeededIsDeterministic(t *testing.T) {
+	levels := func() []int {
+		l := newSeededSkipList(42)
+		var levels []int
+		for v := 0; v < 1000; v++ {
+			l.Insert(v)
+			levels = append(levels, l.Level())
+		}
+		return levels
+	}
+
+	if a, b := levels(), levels(); !equalIntSlices(a, b) {
+		t.Error("Expected lists with the same seed to have the same levels")
+	}
+}
+
+func TestSkipListOptions(t *testing.T) {
+	tt := []struct {
+		name        string
+		options     *gostrutures.SkipListOptions
+		expectPanic bool
+	}{
+		{"default options", nil, false},
+		{"single level", &gostrutures.SkipListOptions{MaxLevel: 1}, false},
+		{"custom probability", &gostrutures.SkipListOptions{MaxLevel: 4, Probability: 0.5}, false},
+		{"negative max level", &gostrutures.SkipListOptions{MaxLevel: -1}, true},
+		{"probability of one", &gostrutures.SkipListOptions{Probability: 1}, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if panicked := recover() != nil; panicked != tc.expectPanic {
+					t.Errorf("Expected new skip list panic to be '%v'. Got '%v'", tc.expectPanic, panicked)
+				}
+			}()
+
+			l := gostrutures.NewSkipList(tc.options)
+			for v := 0; v < 1000; v++ {
+				l.Insert(v)
+			}
+
+			maxLevel := gostrutures.DefaultSkipListMaxLevel
+			if tc.options != nil {
+				maxLevel = tc.options.MaxLevel
+			}
+			if l.Level() > maxLevel || l.Len() != 1000 {
+				t.Errorf("Expected level to be at most '%v' with '1000' values. Got '%v, %v'", maxLevel, l.Level(), l.Len())
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package gostrutures
+
+// Stack is a LIFO container of elements (Item).
+//
+// Read/Write operations are not safe for concurrent mutation by multiple goroutines.
+type Stack []Item
+
+// NewStack build an empty Stack.
+func NewStack() *Stack {
+	return &Stack{}
+}
+
+// Push adds an element (Item) to the top of the stack.
+func (s *Stack) Push(value Item) {
+	*s = append(*s, value)
+}
+
+// Pop retrieves and removes the top of this stack, or returns nil if this stack is empty.
+// Use TryPop to tell apart an empty stack from a nil Item.
+func (s *Stack) Pop() Item {
+	item, _ := s.TryPop()
+	return item
+}
+
+// TryPop retrieves and removes the top of this stack. Return false if this stack is empty.
+func (s *Stack) TryPop() (Item, bool) {
+	item, ok := s.TryPeek()
+	if ok {
+		// release the reference so the item can be garbage collected
+		(*s)[s.Size()-1] = nil
+		*s = (*s)[:s.Size()-1]
+	}
+	return item, ok
+}
+
+// Peek returns but does not remove, the top of this stack, or returns nil if this stack is empty.
+// Use TryPeek to tell apart an empty stack from a nil Item.
+func (s *Stack) Peek() Item {
+	item, _ := s.TryPeek()
+	return item
+}
+
+// TryPeek returns but does not remove, the top of this stack. Return false if this stack is empty.
+func (s *Stack) TryPeek() (Item, bool) {
+	if s.Size() == 0 {
+		return nil, false
+	}
+	return (*s)[s.Size()-1], true
+}
+
+// Size returns the number of Items in the stack
+func (s *Stack) Size() int {
+	return len(*s)
+}
+
+// IsEmpty returns true if the stack is empty
+func (s *Stack) IsEmpty() bool {
+	return len(*s) == 0
+}
+
+// Clear removes all the Items from the stack
+func (s *Stack) Clear() {
+	*s = nil
+}
@@ -0,0 +1,168 @@
+package gostrutures_test
+
+import (
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func TestNewStack(t *testing.T) {
+	s := gostrutures.NewStack()
+	if s == nil {
+		t.Error("Expected stack to be not nil")
+	}
+}
+
+func TestStackPush(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+	}{
+		{"empty stack", []int{}},
+		{"stack with elements", []int{3, 2, 3, 4}},
+	}
+
+	for _, tc := range tt {
+		stack := new(gostrutures.Stack)
+		for _, value := range tc.insertValues {
+			stack.Push(value)
+		}
+
+		for i := range tc.insertValues {
+			if (*stack)[i] != tc.insertValues[i] {
+				t.Errorf("Expected items into the stack to be '%v'. Got '%v'", tc.insertValues[i], (*stack)[i])
+				break
+			}
+		}
+	}
+}
+
+func TestStackSize(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+	}{
+		{"empty stack", []int{}},
+		{"stack with elements", []int{3, 2, 3, 4}},
+	}
+
+	for _, tc := range tt {
+		stack := new(gostrutures.Stack)
+		for _, value := range tc.insertValues {
+			stack.Push(value)
+		}
+
+		if stack.Size() != len(tc.insertValues) {
+			t.Errorf("Expected stack size to be '%v'. Got '%v'", len(tc.insertValues), stack.Size())
+		}
+	}
+}
+
+func TestStackPeek(t *testing.T) {
+	// GIVEN
+	stack := new(gostrutures.Stack)
+	insertValues, peekElement := []int{3, 2, 5, 4}, 4
+	for _, value := range insertValues {
+		stack.Push(value)
+	}
+	// When peek
+	result := stack.Peek()
+	// Then
+	if result != peekElement {
+		t.Errorf("Expected peek element to be '%v'. Got '%v'", peekElement, result)
+	}
+
+	if stack.Size() != len(insertValues) {
+		t.Errorf("Expected stack size after peek to be '%v'. Got '%v'", len(insertValues), stack.Size())
+	}
+}
+
+func TestStackPop(t *testing.T) {
+	// Given
+	stack := new(gostrutures.Stack)
+	for _, value := range []int{3, 2, 5, 4} {
+		stack.Push(value)
+	}
+	// When pop
+	for _, expected := range []int{4, 5, 2, 3} {
+		result := stack.Pop()
+		// Then
+		if result != expected {
+			t.Errorf("Expected pop element to be '%v'. Got '%v'", expected, result)
+		}
+	}
+
+	if stack.Size() != 0 {
+		t.Errorf("Expected stack size after pop to be '%v'. Got '%v'", 0, stack.Size())
+	}
+}
+
+func TestStackEmpty(t *testing.T) {
+	stack := new(gostrutures.Stack)
+
+	if result := stack.Pop(); result != nil {
+		t.Errorf("Expected pop element to be nil. Got '%v'", result)
+	}
+
+	if result := stack.Peek(); result != nil {
+		t.Errorf("Expected peek element to be nil. Got '%v'", result)
+	}
+
+	if result, ok := stack.TryPop(); result != nil || ok {
+		t.Errorf("Expected try pop to be 'nil, false'. Got '%v, %v'", result, ok)
+	}
+
+	if result, ok := stack.TryPeek(); result != nil || ok {
+		t.Errorf("Expected try peek to be 'nil, false'. Got '%v, %v'", result, ok)
+	}
+}
+
+func TestStackTryPopNilItem(t *testing.T) {
+	stack := new(gostrutures.Stack)
+	stack.Push(1)
+	stack.Push(nil)
+
+	for _, expected := range []gostrutures.Item{nil, 1} {
+		if result, ok := stack.TryPeek(); result != expected || !ok {
+			t.Errorf("Expected try peek to be '%v, true'. Got '%v, %v'", expected, result, ok)
+		}
+
+		if result, ok := stack.TryPop(); result != expected || !ok {
+			t.Errorf("Expected try pop to be '%v, true'. Got '%v, %v'", expected, result, ok)
+		}
+	}
+}
+
+func TestStackIsEmpty(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+		expected     bool
+	}{
+		{"empty stack", []int{}, true},
+		{"stack with elements", []int{3, 2, 3, 4}, false},
+	}
+
+	for _, tc := range tt {
+		stack := new(gostrutures.Stack)
+		for _, value := range tc.insertValues {
+			stack.Push(value)
+		}
+
+		if stack.IsEmpty() != tc.expected {
+			t.Errorf("Expected stack IsEmpty to be '%v'. Got '%v'", tc.expected, stack.IsEmpty())
+		}
+	}
+}
+
+func TestStackClear(t *testing.T) {
+	stack := new(gostrutures.Stack)
+	for _, value := range []int{3, 2, 3, 4} {
+		stack.Push(value)
+	}
+
+	stack.Clear()
+	if !stack.IsEmpty() {
+		t.Errorf("Expected stack IsEmpty after clear to be '%v'. Got '%v'", true, stack.IsEmpty())
+	}
+}
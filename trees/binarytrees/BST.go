@@ -20,7 +20,7 @@ type BST struct {
 
 // New build a BST with the root.
 func New(value int) *BST {
-	return &BST{NewBNode(value), 0}
+	return &BST{NewBNode(value), 1}
 }
 
 // Root returns the root node of the tree.
@@ -111,14 +111,18 @@ func postOrderTraverse(node *BNode, f func(int)) {
 
 // BreadthFirstTraverse visits all the nodes by levels from top to bottom and from left to right.
 func (t *BST) BreadthFirstTraverse(f func(int)) {
-	if t.root == nil {
+	breadthFirstTraverse(t.root, f)
+}
+
+func breadthFirstTraverse(root *BNode, f func(int)) {
+	if root == nil {
 		return
 	}
 
-	queue := gostrutures.Queue{}
-	queue.Push(t.root)
+	queue := gostrutures.TypedQueue[*BNode]{}
+	queue.Push(root)
 	for {
-		node := queue.Pop().(*BNode)
+		node, _ := queue.Pop()
 		f(node.Value)
 		if node.Left != nil {
 			queue.Push(node.Left)
@@ -250,6 +254,30 @@ func (t *BST) Len() int {
 	return t.length
 }
 
+// Validate returns true if every node of the tree keeps the binary search tree ordering
+// and the number of nodes matches Len.
+func (t *BST) Validate() bool {
+	count, valid := validateNode(t.root, nil, nil)
+	return valid && count == t.length
+}
+
+func validateNode(node *BNode, min, max *int) (int, bool) {
+	if node == nil {
+		return 0, true
+	}
+
+	if (min != nil && node.Value <= *min) || (max != nil && node.Value >= *max) {
+		return 0, false
+	}
+
+	left, valid := validateNode(node.Left, min, &node.Value)
+	if !valid {
+		return 0, false
+	}
+	right, valid := validateNode(node.Right, &node.Value, max)
+	return left + right + 1, valid
+}
+
 // Height return the height of a tree
 func (t *BST) Height() int {
 	return nodeHeight(t.root)
@@ -287,6 +315,59 @@ func findLCA(node *BNode, v1, v2 int) *BNode {
 	return nil
 }
 
+// PathTo returns the values of the nodes from the root to the node with the given value.
+// Return false if the value doesn't exist in the tree.
+func (t *BST) PathTo(value int) ([]int, bool) {
+	return pathToNode(t.root, value)
+}
+
+func pathToNode(node *BNode, value int) ([]int, bool) {
+	var path []int
+	for node != nil {
+		path = append(path, node.Value)
+		if node.Value == value {
+			return path, true
+		}
+
+		if node.Value > value {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return nil, false
+}
+
+// AncestorsOf returns the values of the ancestors of the node with the given value, from
+// the root to its parent. Return false if the value doesn't exist in the tree.
+func (t *BST) AncestorsOf(value int) ([]int, bool) {
+	path, found := t.PathTo(value)
+	if !found {
+		return nil, false
+	}
+	return path[:len(path)-1], true
+}
+
+// Depth returns the number of edges from the root to the node with the given value.
+// Return false if the value doesn't exist in the tree.
+func (t *BST) Depth(value int) (int, bool) {
+	path, found := t.PathTo(value)
+	return len(path) - 1, found
+}
+
+// Distance returns the number of edges between the nodes with the given values, going
+// through their LCA. Return false if any of the values doesn't exist in the tree.
+func (t *BST) Distance(v1, v2 int) (int, bool) {
+	lca := findLCA(t.root, v1, v2)
+	if lca == nil {
+		return -1, false
+	}
+
+	path1, _ := pathToNode(lca, v1)
+	path2, _ := pathToNode(lca, v2)
+	return len(path1) - 1 + len(path2) - 1, true
+}
+
 // Print prints a visual representation of the bst into an io.Writer
 func (t *BST) Print(w io.Writer) {
 	PrintTreeFromNode(w, t.Root(), 0)
@@ -0,0 +1,292 @@
+package binarytrees_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures/trees/binarytrees"
+)
+
+// operations that the model checker applies over a BST and a reference sorted slice.
+const (
+	opInsert = iota
+	opRemove
+	opSearch
+	opMin
+	opMax
+	opLCA
+	opsCount
+)
+
+// values are kept in a small range to force duplicates, misses and removals of inner nodes.
+const opValueRange = 32
+
+type bstOp struct {
+	kind   int
+	v1, v2 int
+}
+
+func (op bstOp) String() string {
+	switch op.kind {
+	case opInsert:
+		return fmt.Sprintf("Insert(%d)", op.v1)
+	case opRemove:
+		return fmt.Sprintf("Remove(%d)", op.v1)
+	case opSearch:
+		return fmt.Sprintf("Search(%d)", op.v1)
+	case opMin:
+		return "Min()"
+	case opMax:
+		return "Max()"
+	default:
+		return fmt.Sprintf("LCA(%d, %d)", op.v1, op.v2)
+	}
+}
+
+// decodeOps turns arbitrary bytes into a sequence of operations, three bytes per operation.
+func decodeOps(data []byte) []bstOp {
+	ops := make([]bstOp, 0, len(data)/3)
+	for i := 0; i+2 < len(data); i += 3 {
+		ops = append(ops, bstOp{
+			kind: int(data[i]) % opsCount,
+			v1:   int(data[i+1]) % opValueRange,
+			v2:   int(data[i+2]) % opValueRange,
+		})
+	}
+	return ops
+}
+
+func randOps(r *rand.Rand, length int) []bstOp {
+	ops := make([]bstOp, length)
+	for i := range ops {
+		ops[i] = bstOp{r.Intn(opsCount), r.Intn(opValueRange), r.Intn(opValueRange)}
+	}
+	return ops
+}
+
+// sortedModel is the reference implementation: a sorted slice without duplicates.
+type sortedModel []int
+
+func (m sortedModel) index(value int) (int, bool) {
+	i := sort.SearchInts(m, value)
+	return i, i < len(m) && m[i] == value
+}
+
+func (m *sortedModel) insert(value int) bool {
+	i, found := m.index(value)
+	if found {
+		return false
+	}
+	*m = append(*m, 0)
+	copy((*m)[i+1:], (*m)[i:])
+	(*m)[i] = value
+	return true
+}
+
+func (m *sortedModel) remove(value int) bool {
+	i, found := m.index(value)
+	if !found {
+		return false
+	}
+	*m = append((*m)[:i], (*m)[i+1:]...)
+	return true
+}
+
+// checkOps runs the operations against a BST and the model. It returns an error describing
+// the first divergence, checking Len, in order output and Validate after every step.
+func checkOps(ops []bstOp) error {
+	bst := binarytrees.BST{}
+	model := sortedModel{}
+
+	for step, op := range ops {
+		if err := applyOp(&bst, &model, op); err != nil {
+			return fmt.Errorf("step %d %v: %v", step, op, err)
+		}
+
+		if bst.Len() != len(model) {
+			return fmt.Errorf("step %d %v: expected Len to be %v. Got %v", step, op, len(model), bst.Len())
+		}
+
+		if result := inOrderValues(&bst); !equalInts(result, model) {
+			return fmt.Errorf("step %d %v: expected in order traversal to be %v. Got %v", step, op, model, result)
+		}
+
+		if !bst.Validate() {
+			return fmt.Errorf("step %d %v: expected tree to be valid", step, op)
+		}
+	}
+	return nil
+}
+
+func applyOp(bst *binarytrees.BST, model *sortedModel, op bstOp) error {
+	switch op.kind {
+	case opInsert:
+		expected, result := model.insert(op.v1), bst.Insert(op.v1)
+		if result != expected {
+			return fmt.Errorf("expected insert result to be %v. Got %v", expected, result)
+		}
+	case opRemove:
+		expected, result := model.remove(op.v1), bst.Remove(op.v1)
+		if result != expected {
+			return fmt.Errorf("expected remove result to be %v. Got %v", expected, result)
+		}
+	case opSearch:
+		_, expected := model.index(op.v1)
+		result := bst.Search(op.v1)
+		if (result != nil) != expected || (result != nil && result.Value != op.v1) {
+			return fmt.Errorf("expected search found to be %v. Got %v", expected, result)
+		}
+	case opMin, opMax:
+		result, expected := bst.Min(), 0
+		if op.kind == opMax {
+			result = bst.Max()
+			expected = len(*model) - 1
+		}
+		if len(*model) == 0 {
+			if result != nil {
+				return fmt.Errorf("expected nil node. Got %v", result.Value)
+			}
+			return nil
+		}
+		if result == nil || result.Value != (*model)[expected] {
+			return fmt.Errorf("expected %v. Got %v", (*model)[expected], result)
+		}
+	case opLCA:
+		return checkLCA(bst, *model, op.v1, op.v2, bst.LCA(op.v1, op.v2))
+	}
+	return nil
+}
+
+// checkLCA verifies the result of the LCA against the one found by binarytrees.LCA, which
+// doesn't rely on the ordering of the tree, comparing the nodes and not only their values.
+func checkLCA(bst *binarytrees.BST, model sortedModel, v1, v2 int, result *binarytrees.BNode) error {
+	_, found1 := model.index(v1)
+	_, found2 := model.index(v2)
+	if !found1 || !found2 {
+		if result != nil {
+			return fmt.Errorf("expected LCA of missing value to be nil. Got %v", result.Value)
+		}
+		return nil
+	}
+
+	expected := binarytrees.LCA(bst.Root(), v1, v2)
+	if result != expected {
+		return fmt.Errorf("expected LCA to be %v. Got %v", expected, result)
+	}
+	return nil
+}
+
+// shrinkOps reduces a failing sequence of operations to a minimal one that still fails,
+// first removing chunks of decreasing size and then lowering the values.
+func shrinkOps(ops []bstOp, fails func([]bstOp) bool) []bstOp {
+	for chunk := len(ops) / 2; chunk > 0; chunk /= 2 {
+		for start := 0; start+chunk <= len(ops); {
+			candidate := append(append([]bstOp{}, ops[:start]...), ops[start+chunk:]...)
+			if fails(candidate) {
+				ops = candidate
+				continue
+			}
+			start++
+		}
+	}
+
+	for i := range ops {
+		for _, field := range []*int{&ops[i].v1, &ops[i].v2} {
+			for *field > 0 {
+				original := *field
+				*field--
+				if !fails(ops) {
+					*field = original
+					break
+				}
+			}
+		}
+	}
+	return ops
+}
+
+func failsCheck(ops []bstOp) bool {
+	return checkOps(ops) != nil
+}
+
+func reportFailure(t *testing.T, ops []bstOp) {
+	if err := checkOps(ops); err != nil {
+		minimal := shrinkOps(ops, failsCheck)
+		t.Fatalf("Expected BST to behave as the model. Got %v\nMinimal repro: %v", checkOps(minimal), minimal)
+	}
+}
+
+func TestBSTModel(t *testing.T) {
+	for seed := int64(0); seed < 200; seed++ {
+		t.Run(fmt.Sprintf("seed %d", seed), func(t *testing.T) {
+			reportFailure(t, randOps(rand.New(rand.NewSource(seed)), 200))
+		})
+	}
+}
+
+func TestCheckLCA(t *testing.T) {
+	bst := &binarytrees.BST{}
+	fillTreeWithList(bst, []int{5, 3, 7, 4})
+	model := sortedModel{3, 4, 5, 7}
+
+	tt := []struct {
+		name      string
+		v1, v2    int
+		result    *binarytrees.BNode
+		expectErr bool
+	}{
+		{"right LCA", 3, 7, bst.Search(5), false},
+		{"ancestor of itself", 3, 4, bst.Search(3), false},
+		{"node between the values that is not the LCA", 3, 5, bst.Search(4), true},
+		{"missing LCA", 3, 7, nil, true},
+		{"LCA of a missing value", 3, 6, bst.Search(5), true},
+		{"nil LCA of a missing value", 3, 6, nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := checkLCA(bst, model, tc.v1, tc.v2, tc.result); (err != nil) != tc.expectErr {
+				t.Errorf("Expected check LCA error to be '%v'. Got '%v'", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestShrinkOps(t *testing.T) {
+	// fails when a value greater than 3 is inserted and later removed
+	fails := func(ops []bstOp) bool {
+		inserted := false
+		for _, op := range ops {
+			if op.kind == opInsert && op.v1 > 3 {
+				inserted = true
+			}
+			if inserted && op.kind == opRemove && op.v1 > 3 {
+				return true
+			}
+		}
+		return false
+	}
+
+	ops := []bstOp{
+		{opSearch, 1, 0}, {opInsert, 10, 0}, {opMin, 0, 0}, {opInsert, 2, 0},
+		{opLCA, 2, 10}, {opRemove, 20, 0}, {opMax, 0, 0},
+	}
+	expected := []bstOp{{opInsert, 4, 0}, {opRemove, 4, 0}}
+
+	result := shrinkOps(ops, fails)
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Expected shrunk operations to be '%v'. Got '%v'", expected, result)
+	}
+}
+
+func FuzzBST(f *testing.F) {
+	f.Add([]byte{opInsert, 5, 0, opInsert, 3, 0, opInsert, 7, 0, opLCA, 3, 7})
+	f.Add([]byte{opInsert, 5, 0, opInsert, 3, 0, opInsert, 4, 0, opRemove, 5, 0, opMin, 0, 0})
+	f.Add([]byte{opRemove, 1, 0, opSearch, 1, 0, opMax, 0, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		reportFailure(t, decodeOps(data))
+	})
+}
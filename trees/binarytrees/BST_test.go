@@ -23,6 +23,24 @@ func TestNew(t *testing.T) {
 	if bst.Root().Value != 1 {
 		t.Errorf("Expected root value to be '1'. Got '%v'", bst.Root().Value)
 	}
+
+	if bst.Len() != 1 {
+		t.Errorf("Expected tree len to be '1'. Got '%v'", bst.Len())
+	}
+}
+
+func TestNewValidate(t *testing.T) {
+	bst := binarytrees.New(5)
+	bst.Insert(3)
+	bst.Insert(8)
+
+	if bst.Len() != 3 {
+		t.Errorf("Expected tree len to be '3'. Got '%v'", bst.Len())
+	}
+
+	if !bst.Validate() {
+		t.Error("Expected tree built with New to be valid")
+	}
 }
 
 func TestBSTInsert(t *testing.T) {
@@ -75,7 +93,7 @@ func TestBSTInsert(t *testing.T) {
 				}
 			}
 
-			if bst.Root == nil {
+			if bst.Root() == nil {
 				t.Error("Expected root to be not nil")
 			}
 
@@ -626,6 +644,55 @@ func TestBSTHeight(t *testing.T) {
 	}
 }
 
+func TestBSTValidate(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+		corrupt      func(bst *binarytrees.BST)
+		expected     bool
+	}{
+		{"empty tree", []int{}, func(*binarytrees.BST) {}, true},
+		{"balanced tree", []int{5, 3, 1, 4, 7, 9, 6}, func(*binarytrees.BST) {}, true},
+		{
+			"left child greater than parent",
+			[]int{5, 3, 7},
+			func(bst *binarytrees.BST) { bst.Root().Left.Value = 6 },
+			false,
+		},
+		{
+			"grandchild out of ancestor range",
+			[]int{5, 3, 4},
+			func(bst *binarytrees.BST) { bst.Root().Left.Right.Value = 8 },
+			false,
+		},
+		{
+			"duplicate value",
+			[]int{5, 7},
+			func(bst *binarytrees.BST) { bst.Root().Right.Value = 5 },
+			false,
+		},
+		{
+			"length mismatch",
+			[]int{5, 3, 7},
+			func(bst *binarytrees.BST) { bst.Root().Left = nil },
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			bst := binarytrees.BST{}
+			fillTreeWithList(&bst, tc.insertValues)
+			tc.corrupt(&bst)
+
+			result := bst.Validate()
+			if result != tc.expected {
+				t.Errorf("Expected validate to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
 func TestBSTLCA(t *testing.T) {
 	bst := binarytrees.BST{}
 	fillTreeWithList(&bst, []int{5, 3, 1, 4, 7, 9, 6})
@@ -675,6 +742,128 @@ func TestBSTLCA(t *testing.T) {
 	}
 }
 
+func TestBSTPathTo(t *testing.T) {
+	bst := binarytrees.BST{}
+	fillTreeWithList(&bst, []int{5, 3, 1, 4, 7, 9, 6})
+
+	tt := []struct {
+		name          string
+		value         int
+		expectedPath  []int
+		expectedFound bool
+	}{
+		{"root", 5, []int{5}, true},
+		{"leaf into left branch", 4, []int{5, 3, 4}, true},
+		{"leaf into right branch", 6, []int{5, 7, 6}, true},
+		{"missing value", 8, []int{}, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			path, found := bst.PathTo(tc.value)
+			if found != tc.expectedFound {
+				t.Fatalf("Expected found to be '%v'. Got '%v'", tc.expectedFound, found)
+			}
+
+			if !equalInts(path, tc.expectedPath) {
+				t.Errorf("Expected path to be '%v'. Got '%v'", tc.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestBSTAncestorsOf(t *testing.T) {
+	bst := binarytrees.BST{}
+	fillTreeWithList(&bst, []int{5, 3, 1, 4, 7, 9, 6})
+
+	tt := []struct {
+		name              string
+		value             int
+		expectedAncestors []int
+		expectedFound     bool
+	}{
+		{"root", 5, []int{}, true},
+		{"inner node", 3, []int{5}, true},
+		{"leaf", 9, []int{5, 7}, true},
+		{"missing value", 2, []int{}, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ancestors, found := bst.AncestorsOf(tc.value)
+			if found != tc.expectedFound {
+				t.Fatalf("Expected found to be '%v'. Got '%v'", tc.expectedFound, found)
+			}
+
+			if !equalInts(ancestors, tc.expectedAncestors) {
+				t.Errorf("Expected ancestors to be '%v'. Got '%v'", tc.expectedAncestors, ancestors)
+			}
+		})
+	}
+}
+
+func TestBSTDepth(t *testing.T) {
+	bst := binarytrees.BST{}
+	fillTreeWithList(&bst, []int{5, 3, 1, 4, 7, 9, 6})
+
+	tt := []struct {
+		name          string
+		value         int
+		expectedDepth int
+		expectedFound bool
+	}{
+		{"root", 5, 0, true},
+		{"inner node", 7, 1, true},
+		{"leaf", 1, 2, true},
+		{"missing value", 10, -1, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			depth, found := bst.Depth(tc.value)
+			if found != tc.expectedFound {
+				t.Fatalf("Expected found to be '%v'. Got '%v'", tc.expectedFound, found)
+			}
+
+			if depth != tc.expectedDepth {
+				t.Errorf("Expected depth to be '%v'. Got '%v'", tc.expectedDepth, depth)
+			}
+		})
+	}
+}
+
+func TestBSTDistance(t *testing.T) {
+	bst := binarytrees.BST{}
+	fillTreeWithList(&bst, []int{5, 3, 1, 4, 7, 9, 6})
+
+	tt := []struct {
+		name             string
+		v1, v2           int
+		expectedDistance int
+		expectedFound    bool
+	}{
+		{"same node", 4, 4, 0, true},
+		{"parent and child", 5, 7, 1, true},
+		{"siblings", 1, 4, 2, true},
+		{"through the root", 1, 9, 4, true},
+		{"ancestor and descendant", 5, 6, 2, true},
+		{"missing value", 1, 10, -1, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			distance, found := bst.Distance(tc.v1, tc.v2)
+			if found != tc.expectedFound {
+				t.Fatalf("Expected found to be '%v'. Got '%v'", tc.expectedFound, found)
+			}
+
+			if distance != tc.expectedDistance {
+				t.Errorf("Expected distance to be '%v'. Got '%v'", tc.expectedDistance, distance)
+			}
+		})
+	}
+}
+
 func TestBSTPrint(t *testing.T) {
 	tt := []struct {
 		name         string
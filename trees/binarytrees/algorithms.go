@@ -0,0 +1,371 @@
+package binarytrees
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+// SerializeNilNode is the token used by Serialize to represent a missing child.
+const SerializeNilNode = "#"
+
+// Mirror returns a copy of the binary tree where the left and right children of every node are swapped.
+func Mirror(root *BNode) *BNode {
+	if root == nil {
+		return nil
+	}
+
+	return &BNode{root.Value, Mirror(root.Right), Mirror(root.Left)}
+}
+
+// Invert swaps in place the left and right children of every node of the binary tree and returns its root.
+func Invert(root *BNode) *BNode {
+	if root == nil {
+		return nil
+	}
+
+	root.Left, root.Right = Invert(root.Right), Invert(root.Left)
+	return root
+}
+
+// IsSymmetric returns true if the binary tree is a mirror of itself around its center.
+func IsSymmetric(root *BNode) bool {
+	if root == nil {
+		return true
+	}
+
+	return isMirror(root.Left, root.Right)
+}
+
+func isMirror(left, right *BNode) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+
+	return left.Value == right.Value && isMirror(left.Left, right.Right) && isMirror(left.Right, right.Left)
+}
+
+// IsBalanced returns true if for every node the heights of its left and right subtrees differ by at most one.
+func IsBalanced(root *BNode) bool {
+	return balancedHeight(root) >= 0
+}
+
+// balancedHeight returns the height of the node or -1 if it's not balanced.
+func balancedHeight(node *BNode) int {
+	if node == nil {
+		return 0
+	}
+
+	left, right := balancedHeight(node.Left), balancedHeight(node.Right)
+	if left < 0 || right < 0 || left-right > 1 || right-left > 1 {
+		return -1
+	}
+	return intMax(left, right) + 1
+}
+
+// IsComplete returns true if every level of the binary tree, except possibly the last, is completely
+// filled and all the nodes of the last level are as far left as possible.
+func IsComplete(root *BNode) bool {
+	if root == nil {
+		return true
+	}
+
+	queue := gostrutures.TypedQueue[*BNode]{}
+	queue.Push(root)
+	foundGap := false
+	for !queue.IsEmpty() {
+		node, _ := queue.Pop()
+		for _, child := range []*BNode{node.Left, node.Right} {
+			if child == nil {
+				foundGap = true
+				continue
+			}
+			if foundGap {
+				return false
+			}
+			queue.Push(child)
+		}
+	}
+	return true
+}
+
+// IsFull returns true if every node of the binary tree has either zero or two children.
+func IsFull(root *BNode) bool {
+	if root == nil {
+		return true
+	}
+
+	if (root.Left == nil) != (root.Right == nil) {
+		return false
+	}
+	return IsFull(root.Left) && IsFull(root.Right)
+}
+
+// Diameter returns the number of edges of the longest path between any two nodes of the binary tree.
+func Diameter(root *BNode) int {
+	diameter := 0
+	diameterHeight(root, &diameter)
+	return diameter
+}
+
+func diameterHeight(node *BNode, diameter *int) int {
+	if node == nil {
+		return 0
+	}
+
+	left, right := diameterHeight(node.Left, diameter), diameterHeight(node.Right, diameter)
+	*diameter = intMax(*diameter, left+right)
+	return intMax(left, right) + 1
+}
+
+// MaxPathSum returns the maximum sum of the values of any non-empty path between two nodes of the
+// binary tree. Return 0 for an empty tree.
+func MaxPathSum(root *BNode) int {
+	if root == nil {
+		return 0
+	}
+
+	maxSum := root.Value
+	maxGain(root, &maxSum)
+	return maxSum
+}
+
+// maxGain returns the maximum sum of a path that starts at node and goes down.
+func maxGain(node *BNode, maxSum *int) int {
+	if node == nil {
+		return 0
+	}
+
+	left, right := intMax(maxGain(node.Left, maxSum), 0), intMax(maxGain(node.Right, maxSum), 0)
+	*maxSum = intMax(*maxSum, node.Value+left+right)
+	return node.Value + intMax(left, right)
+}
+
+// Serialize returns a comma separated representation of the binary tree in pre order, where the
+// missing children are represented with SerializeNilNode.
+func Serialize(root *BNode) string {
+	var tokens []string
+	serializeNode(root, &tokens)
+	return strings.Join(tokens, ",")
+}
+
+func serializeNode(node *BNode, tokens *[]string) {
+	if node == nil {
+		*tokens = append(*tokens, SerializeNilNode)
+		return
+	}
+
+	*tokens = append(*tokens, strconv.Itoa(node.Value))
+	serializeNode(node.Left, tokens)
+	serializeNode(node.Right, tokens)
+}
+
+// Deserialize builds a binary tree from the representation returned by Serialize.
+func Deserialize(data string) (*BNode, error) {
+	tokens := strings.Split(data, ",")
+	root, read, err := deserializeNode(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if read != len(tokens) {
+		return nil, fmt.Errorf("binarytrees: unexpected token %q after the tree at position %d", tokens[read], read)
+	}
+	return root, nil
+}
+
+// deserializeNode builds the node from the first tokens and returns how many tokens were read.
+func deserializeNode(tokens []string) (*BNode, int, error) {
+	if len(tokens) == 0 {
+		return nil, 0, fmt.Errorf("binarytrees: unexpected end of the serialized tree")
+	}
+
+	if tokens[0] == SerializeNilNode {
+		return nil, 1, nil
+	}
+
+	value, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return nil, 0, fmt.Errorf("binarytrees: invalid node value %q", tokens[0])
+	}
+
+	node := NewBNode(value)
+	read := 1
+	for _, child := range []**BNode{&node.Left, &node.Right} {
+		var n int
+		*child, n, err = deserializeNode(tokens[read:])
+		if err != nil {
+			return nil, 0, err
+		}
+		read += n
+	}
+	return node, read, nil
+}
+
+// Flatten transforms in place the binary tree into a linked list in pre order, where every
+// node has its next node as Right child and a nil Left child. Returns the head of the list.
+func Flatten(root *BNode) *BNode {
+	flattenNode(root)
+	return root
+}
+
+// flattenNode returns the tail of the flattened list.
+func flattenNode(node *BNode) *BNode {
+	if node == nil {
+		return nil
+	}
+
+	left, right := node.Left, node.Right
+	node.Left = nil
+	tail := node
+	if left != nil {
+		tail.Right = left
+		tail = flattenNode(left)
+	}
+	if right != nil {
+		tail.Right = right
+		tail = flattenNode(right)
+	}
+	return tail
+}
+
+// RightSideView returns the values of the nodes visible from the right side of the binary tree,
+// from top to bottom.
+func RightSideView(root *BNode) []int {
+	var view []int
+	for _, level := range levelOrder(root) {
+		view = append(view, level[len(level)-1])
+	}
+	return view
+}
+
+// ZigzagLevelOrder returns the values of the binary tree by levels, from left to right in the even
+// levels and from right to left in the odd ones.
+func ZigzagLevelOrder(root *BNode) [][]int {
+	levels := levelOrder(root)
+	for i := 1; i < len(levels); i += 2 {
+		level := levels[i]
+		for l, r := 0, len(level)-1; l < r; l, r = l+1, r-1 {
+			level[l], level[r] = level[r], level[l]
+		}
+	}
+	return levels
+}
+
+// levelOrder returns the values of the binary tree by levels from top to bottom and from left to right.
+func levelOrder(root *BNode) [][]int {
+	if root == nil {
+		return nil
+	}
+
+	var levels [][]int
+	queue := gostrutures.TypedQueue[*BNode]{}
+	queue.Push(root)
+	for !queue.IsEmpty() {
+		level := make([]int, queue.Size())
+		for i := range level {
+			node, _ := queue.Pop()
+			level[i] = node.Value
+			if node.Left != nil {
+				queue.Push(node.Left)
+			}
+			if node.Right != nil {
+				queue.Push(node.Right)
+			}
+		}
+		levels = append(levels, level)
+	}
+	return levels
+}
+
+// VerticalOrder returns the values of the binary tree by columns from left to right. Inside a column
+// the values are ordered from top to bottom and from left to right.
+func VerticalOrder(root *BNode) [][]int {
+	if root == nil {
+		return nil
+	}
+
+	type columnNode struct {
+		node   *BNode
+		column int
+	}
+
+	columns := make(map[int][]int)
+	minColumn, maxColumn := 0, 0
+	queue := gostrutures.TypedQueue[columnNode]{}
+	queue.Push(columnNode{root, 0})
+	for !queue.IsEmpty() {
+		current, _ := queue.Pop()
+		columns[current.column] = append(columns[current.column], current.node.Value)
+		minColumn, maxColumn = intMin(minColumn, current.column), intMax(maxColumn, current.column)
+		if current.node.Left != nil {
+			queue.Push(columnNode{current.node.Left, current.column - 1})
+		}
+		if current.node.Right != nil {
+			queue.Push(columnNode{current.node.Right, current.column + 1})
+		}
+	}
+
+	result := make([][]int, 0, maxColumn-minColumn+1)
+	for column := minColumn; column <= maxColumn; column++ {
+		result = append(result, columns[column])
+	}
+	return result
+}
+
+// BoundaryTraversal returns the values of the boundary of the binary tree counter-clockwise starting
+// from the root: the left boundary from top to bottom, the leaves from left to right and the right
+// boundary from bottom to top.
+func BoundaryTraversal(root *BNode) []int {
+	if root == nil {
+		return nil
+	}
+
+	boundary := []int{root.Value}
+	if isLeaf(root) {
+		return boundary
+	}
+
+	for node := root.Left; node != nil && !isLeaf(node); {
+		boundary = append(boundary, node.Value)
+		if node.Left != nil {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+
+	boundary = appendLeaves(boundary, root)
+
+	var right []int
+	for node := root.Right; node != nil && !isLeaf(node); {
+		right = append(right, node.Value)
+		if node.Right != nil {
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+	for i := len(right) - 1; i >= 0; i-- {
+		boundary = append(boundary, right[i])
+	}
+	return boundary
+}
+
+func appendLeaves(leaves []int, node *BNode) []int {
+	if node == nil {
+		return leaves
+	}
+
+	if isLeaf(node) {
+		return append(leaves, node.Value)
+	}
+	leaves = appendLeaves(leaves, node.Left)
+	return appendLeaves(leaves, node.Right)
+}
+
+func isLeaf(node *BNode) bool {
+	return node.Left == nil && node.Right == nil
+}
@@ -0,0 +1,367 @@
+package binarytrees_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures/trees/binarytrees"
+)
+
+const (
+	// plainTreeSerialized is the serialized representation of plainTree.
+	plainTreeSerialized = "1,2,4,#,#,5,7,#,#,8,#,#,3,#,6,#,#"
+	// symmetricTreeSerialized is a tree with 1 as root and 2(3, 4) and 2(4, 3) as children.
+	symmetricTreeSerialized = "1,2,3,#,#,4,#,#,2,4,#,#,3,#,#"
+	completeTreeSerialized  = "1,2,4,#,#,5,#,#,3,6,#,#,#"
+	fullTreeSerialized      = "1,2,4,#,#,5,#,#,3,#,#"
+	linkedListSerialized    = "1,#,2,#,3,#,#"
+)
+
+func mustDeserialize(t *testing.T, data string) *binarytrees.BNode {
+	root, err := binarytrees.Deserialize(data)
+	if err != nil {
+		t.Fatalf("Expected tree %q to be deserialized. Got error '%v'", data, err)
+	}
+	return root
+}
+
+func TestMirror(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected string
+	}{
+		{"empty tree", "#", "#"},
+		{"only root", "1,#,#", "1,#,#"},
+		{"plain tree", plainTreeSerialized, "1,3,6,#,#,#,2,5,8,#,#,7,#,#,4,#,#"},
+		{"linked list", linkedListSerialized, "1,2,3,#,#,#,#"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			root := mustDeserialize(t, tc.tree)
+
+			result := binarytrees.Serialize(binarytrees.Mirror(root))
+			if result != tc.expected {
+				t.Errorf("Expected mirror to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if original := binarytrees.Serialize(root); original != tc.tree {
+				t.Errorf("Expected original tree to be '%v'. Got '%v'", tc.tree, original)
+			}
+
+			result = binarytrees.Serialize(binarytrees.Invert(root))
+			if result != tc.expected {
+				t.Errorf("Expected inverted tree to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestIsSymmetric(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected bool
+	}{
+		{"empty tree", "#", true},
+		{"only root", "1,#,#", true},
+		{"symmetric tree", symmetricTreeSerialized, true},
+		{"same shape different values", "1,2,3,#,#,4,#,#,2,3,#,#,4,#,#", false},
+		{"different shape", "1,2,#,3,#,#,2,#,3,#,#", false},
+		{"plain tree", plainTreeSerialized, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.IsSymmetric(mustDeserialize(t, tc.tree))
+			if result != tc.expected {
+				t.Errorf("Expected is symmetric to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestIsBalanced(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected bool
+	}{
+		{"empty tree", "#", true},
+		{"only root", "1,#,#", true},
+		{"plain tree", plainTreeSerialized, true},
+		{"linked list", linkedListSerialized, false},
+		{"unbalanced subtree", "1,2,3,4,#,#,#,#,5,6,#,#,7,#,#", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.IsBalanced(mustDeserialize(t, tc.tree))
+			if result != tc.expected {
+				t.Errorf("Expected is balanced to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestIsComplete(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected bool
+	}{
+		{"empty tree", "#", true},
+		{"only root", "1,#,#", true},
+		{"complete tree", completeTreeSerialized, true},
+		{"full tree", fullTreeSerialized, true},
+		{"gap in the last level", "1,2,4,#,#,5,#,#,3,#,6,#,#", false},
+		{"plain tree", plainTreeSerialized, false},
+		{"linked list", linkedListSerialized, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.IsComplete(mustDeserialize(t, tc.tree))
+			if result != tc.expected {
+				t.Errorf("Expected is complete to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestIsFull(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected bool
+	}{
+		{"empty tree", "#", true},
+		{"only root", "1,#,#", true},
+		{"full tree", fullTreeSerialized, true},
+		{"complete tree", completeTreeSerialized, false},
+		{"plain tree", plainTreeSerialized, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.IsFull(mustDeserialize(t, tc.tree))
+			if result != tc.expected {
+				t.Errorf("Expected is full to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestDiameter(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected int
+	}{
+		{"empty tree", "#", 0},
+		{"only root", "1,#,#", 0},
+		{"plain tree", plainTreeSerialized, 5},
+		{"linked list", linkedListSerialized, 2},
+		{"diameter not through the root", "1,2,3,4,#,#,#,5,#,6,#,#,#", 4},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.Diameter(mustDeserialize(t, tc.tree))
+			if result != tc.expected {
+				t.Errorf("Expected diameter to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestMaxPathSum(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected int
+	}{
+		{"empty tree", "#", 0},
+		{"negative root", "-3,#,#", -3},
+		{"plain tree", plainTreeSerialized, 25},
+		{"path not through the root", "-10,9,#,#,20,15,#,#,7,#,#", 42},
+		{"skip negative child", "2,-1,#,#,#", 2},
+		{"all negative", "-2,-1,#,#,-3,#,#", -1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.MaxPathSum(mustDeserialize(t, tc.tree))
+			if result != tc.expected {
+				t.Errorf("Expected max path sum to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestSerialize(t *testing.T) {
+	tt := []struct {
+		name     string
+		root     *binarytrees.BNode
+		expected string
+	}{
+		{"empty tree", nil, "#"},
+		{"only root", binarytrees.NewBNode(-1), "-1,#,#"},
+		{"plain tree", plainTree(), plainTreeSerialized},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.Serialize(tc.root)
+			if result != tc.expected {
+				t.Errorf("Expected serialized tree to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if back := binarytrees.Serialize(mustDeserialize(t, result)); back != result {
+				t.Errorf("Expected deserialized tree to be '%v'. Got '%v'", result, back)
+			}
+		})
+	}
+}
+
+func TestDeserializeErrors(t *testing.T) {
+	tt := []struct {
+		name string
+		data string
+	}{
+		{"empty string", ""},
+		{"invalid value", "1,a,#,#"},
+		{"missing children", "1,2,#"},
+		{"trailing tokens", "1,#,#,2"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			root, err := binarytrees.Deserialize(tc.data)
+			if err == nil {
+				t.Errorf("Expected deserialize error. Got tree '%v'", binarytrees.Serialize(root))
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected []int
+	}{
+		{"empty tree", "#", []int{}},
+		{"only root", "1,#,#", []int{1}},
+		{"plain tree", plainTreeSerialized, []int{1, 2, 4, 5, 7, 8, 3, 6}},
+		{"linked list to left", "1,2,3,#,#,#,#", []int{1, 2, 3}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var result []int
+			for node := binarytrees.Flatten(mustDeserialize(t, tc.tree)); node != nil; node = node.Right {
+				if node.Left != nil {
+					t.Fatalf("Expected left child of '%v' to be nil. Got '%v'", node.Value, node.Left.Value)
+				}
+				result = append(result, node.Value)
+			}
+
+			if !equalInts(result, tc.expected) {
+				t.Errorf("Expected flattened tree to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestRightSideView(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected []int
+	}{
+		{"empty tree", "#", []int{}},
+		{"plain tree", plainTreeSerialized, []int{1, 3, 6, 8}},
+		{"linked list to left", "1,2,3,#,#,#,#", []int{1, 2, 3}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.RightSideView(mustDeserialize(t, tc.tree))
+			if !equalInts(result, tc.expected) {
+				t.Errorf("Expected right side view to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestZigzagLevelOrder(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected [][]int
+	}{
+		{"empty tree", "#", nil},
+		{"only root", "1,#,#", [][]int{{1}}},
+		{"plain tree", plainTreeSerialized, [][]int{{1}, {3, 2}, {4, 5, 6}, {8, 7}}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.ZigzagLevelOrder(mustDeserialize(t, tc.tree))
+			if fmt.Sprint(result) != fmt.Sprint(tc.expected) {
+				t.Errorf("Expected zigzag level order to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestVerticalOrder(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected [][]int
+	}{
+		{"empty tree", "#", nil},
+		{"only root", "1,#,#", [][]int{{1}}},
+		{"plain tree", plainTreeSerialized, [][]int{{4}, {2, 7}, {1, 5}, {3, 8}, {6}}},
+		{"linked list", linkedListSerialized, [][]int{{1}, {2}, {3}}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.VerticalOrder(mustDeserialize(t, tc.tree))
+			if fmt.Sprint(result) != fmt.Sprint(tc.expected) {
+				t.Errorf("Expected vertical order to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestBoundaryTraversal(t *testing.T) {
+	tt := []struct {
+		name     string
+		tree     string
+		expected []int
+	}{
+		{"empty tree", "#", []int{}},
+		{"only root", "1,#,#", []int{1}},
+		{"plain tree", plainTreeSerialized, []int{1, 2, 4, 7, 8, 6, 3}},
+		{"linked list", linkedListSerialized, []int{1, 3, 2}},
+		{
+			"left boundary through right child",
+			"1,2,#,3,4,#,#,5,#,#,6,#,7,#,#",
+			[]int{1, 2, 3, 4, 5, 7, 6},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binarytrees.BoundaryTraversal(mustDeserialize(t, tc.tree))
+			if !equalInts(result, tc.expected) {
+				t.Errorf("Expected boundary traversal to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+}
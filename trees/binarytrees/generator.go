@@ -0,0 +1,162 @@
+package binarytrees
+
+import (
+	"math/rand"
+)
+
+// Distribution defines the order in which the values of a generated tree are inserted.
+type Distribution int
+
+const (
+	// Uniform inserts the values following a uniform random permutation.
+	Uniform Distribution = iota
+	// Sorted inserts the values in ascending order, producing a tree (linked list) to right.
+	Sorted
+	// ReverseSorted inserts the values in descending order, producing a tree (linked list) to left.
+	ReverseSorted
+	// ZigZag inserts alternately the lowest and the highest remaining value,
+	// producing a degenerated tree that zig-zags from right to left.
+	ZigZag
+)
+
+// Generator builds reproducible random binary search trees.
+//
+// Every tree is built from a *rand.Rand so that the same seed always produces
+// the same tree. Together with the tree, the insertion order used is returned,
+// allowing failing cases to be replayed.
+type Generator struct {
+	rand *rand.Rand
+}
+
+// NewGenerator returns a Generator that takes its randomness from r.
+func NewGenerator(r *rand.Rand) *Generator {
+	return &Generator{r}
+}
+
+// NewSeededGenerator returns a Generator backed by a new source initialized with seed.
+func NewSeededGenerator(seed int64) *Generator {
+	return NewGenerator(rand.New(rand.NewSource(seed)))
+}
+
+// Generate returns a BST with the values from 1 to length inserted following the
+// distribution d, and the insertion order used.
+func (g *Generator) Generate(length int, d Distribution) (*BST, []int) {
+	return g.generate(g.Values(length, 0), d)
+}
+
+// GenerateSparse returns a BST with length ascending values separated by random gaps
+// of at most maxGap missing values, inserted following the distribution d, and the
+// insertion order used.
+func (g *Generator) GenerateSparse(length, maxGap int, d Distribution) (*BST, []int) {
+	return g.generate(g.Values(length, maxGap), d)
+}
+
+// GenerateWithHeight returns a BST with the values from 1 to length with exactly the given
+// height, and the insertion order used. Return false when no tree of that length could
+// have the requested height.
+func (g *Generator) GenerateWithHeight(length, height int) (*BST, []int, bool) {
+	if length < 0 || height < minHeight(length) || height > length {
+		return nil, nil, false
+	}
+
+	order := g.heightOrder(g.Values(length, 0), height, make([]int, 0, length))
+	return treeFromOrder(order), order, true
+}
+
+// Values returns length ascending values starting at 1 where two consecutive values
+// are separated by a random gap of at most maxGap missing values.
+func (g *Generator) Values(length, maxGap int) []int {
+	if length <= 0 {
+		return []int{}
+	}
+
+	values := make([]int, length)
+	values[0] = 1
+	for i := 1; i < length; i++ {
+		values[i] = values[i-1] + 1
+		if maxGap > 0 {
+			values[i] += g.rand.Intn(maxGap + 1)
+		}
+	}
+	return values
+}
+
+func (g *Generator) generate(values []int, d Distribution) (*BST, []int) {
+	order := make([]int, len(values))
+	switch d {
+	case Sorted:
+		copy(order, values)
+	case ReverseSorted:
+		for i, v := range values {
+			order[len(values)-1-i] = v
+		}
+	case ZigZag:
+		low, high := 0, len(values)-1
+		for i := range order {
+			if i%2 == 0 {
+				order[i] = values[low]
+				low++
+			} else {
+				order[i] = values[high]
+				high--
+			}
+		}
+	default:
+		for i, j := range g.rand.Perm(len(values)) {
+			order[i] = values[j]
+		}
+	}
+
+	return treeFromOrder(order), order
+}
+
+// heightOrder appends to order an insertion order of the sorted values that produces
+// a tree with exactly the given height. It assumes the height is reachable.
+func (g *Generator) heightOrder(values []int, height int, order []int) []int {
+	if len(values) == 0 {
+		return order
+	}
+
+	// a root is valid when one of its branches can reach height-1 and the other fits below it
+	var roots []int
+	for i := range values {
+		left, right := i, len(values)-1-i
+		if minHeight(left) < height && minHeight(right) < height && intMax(left, right) >= height-1 {
+			roots = append(roots, i)
+		}
+	}
+	root := roots[g.rand.Intn(len(roots))]
+	left, right := values[:root], values[root+1:]
+
+	leftHeight, rightHeight := height-1, height-1
+	if len(left) < height-1 || (len(right) >= height-1 && g.rand.Intn(2) == 0) {
+		leftHeight = g.heightBetween(minHeight(len(left)), intMin(len(left), height-1))
+	} else {
+		rightHeight = g.heightBetween(minHeight(len(right)), intMin(len(right), height-1))
+	}
+
+	order = append(order, values[root])
+	order = g.heightOrder(left, leftHeight, order)
+	return g.heightOrder(right, rightHeight, order)
+}
+
+func (g *Generator) heightBetween(min, max int) int {
+	return min + g.rand.Intn(max-min+1)
+}
+
+func treeFromOrder(order []int) *BST {
+	t := &BST{}
+	for _, v := range order {
+		t.Insert(v)
+	}
+	return t
+}
+
+// minHeight returns the height of a complete binary tree with length nodes.
+func minHeight(length int) int {
+	height := 0
+	for capacity := 0; capacity < length; capacity = capacity*2 + 1 {
+		height++
+	}
+	return height
+}
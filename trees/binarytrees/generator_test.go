@@ -0,0 +1,170 @@
+package binarytrees_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures/trees/binarytrees"
+)
+
+func inOrderValues(bst *binarytrees.BST) []int {
+	result := []int{}
+	bst.InOrderTraverse(func(i int) {
+		result = append(result, i)
+	})
+	return result
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGeneratorGenerate(t *testing.T) {
+	tt := []struct {
+		name           string
+		length         int
+		distribution   binarytrees.Distribution
+		expectedOrder  []int
+		expectedHeight int
+	}{
+		{"sorted", 5, binarytrees.Sorted, []int{1, 2, 3, 4, 5}, 5},
+		{"reverse sorted", 5, binarytrees.ReverseSorted, []int{5, 4, 3, 2, 1}, 5},
+		{"zig-zag", 6, binarytrees.ZigZag, []int{1, 6, 2, 5, 3, 4}, 6},
+		{"empty tree", 0, binarytrees.Sorted, []int{}, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			bst, order := binarytrees.NewSeededGenerator(1).Generate(tc.length, tc.distribution)
+
+			if !equalInts(order, tc.expectedOrder) {
+				t.Errorf("Expected insertion order to be '%v'. Got '%v'", tc.expectedOrder, order)
+			}
+
+			if bst.Len() != tc.length {
+				t.Errorf("Expected Len value to be '%v'. Got '%v'", tc.length, bst.Len())
+			}
+
+			if bst.Height() != tc.expectedHeight {
+				t.Errorf("Expected tree height to be '%v'. Got '%v'", tc.expectedHeight, bst.Height())
+			}
+		})
+	}
+}
+
+func TestGeneratorGenerateUniform(t *testing.T) {
+	bst, order := binarytrees.NewSeededGenerator(42).Generate(50, binarytrees.Uniform)
+
+	if bst.Len() != 50 {
+		t.Errorf("Expected Len value to be '%v'. Got '%v'", 50, bst.Len())
+	}
+
+	if bst.Root().Value != order[0] {
+		t.Errorf("Expected root value to be '%v'. Got '%v'", order[0], bst.Root().Value)
+	}
+
+	expected := binarytrees.NewSeededGenerator(0).Values(50, 0)
+	if result := inOrderValues(bst); !equalInts(result, expected) {
+		t.Errorf("Expected in order traversal to be '%v'. Got '%v'", expected, result)
+	}
+}
+
+func TestGeneratorIsDeterministic(t *testing.T) {
+	tt := []struct {
+		name     string
+		generate func(g *binarytrees.Generator) []int
+	}{
+		{"uniform", func(g *binarytrees.Generator) []int {
+			_, order := g.Generate(100, binarytrees.Uniform)
+			return order
+		}},
+		{"sparse", func(g *binarytrees.Generator) []int {
+			_, order := g.GenerateSparse(100, 5, binarytrees.Uniform)
+			return order
+		}},
+		{"with height", func(g *binarytrees.Generator) []int {
+			_, order, _ := g.GenerateWithHeight(100, 20)
+			return order
+		}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			first := tc.generate(binarytrees.NewSeededGenerator(7))
+			second := tc.generate(binarytrees.NewGenerator(rand.New(rand.NewSource(7))))
+
+			if !equalInts(first, second) {
+				t.Errorf("Expected same seed to produce the same order '%v'. Got '%v'", first, second)
+			}
+		})
+	}
+}
+
+func TestGeneratorGenerateSparse(t *testing.T) {
+	maxGap := 3
+	bst, order := binarytrees.NewSeededGenerator(3).GenerateSparse(30, maxGap, binarytrees.Sorted)
+
+	if bst.Len() != 30 {
+		t.Errorf("Expected Len value to be '%v'. Got '%v'", 30, bst.Len())
+	}
+
+	if order[0] != 1 {
+		t.Errorf("Expected first value to be '%v'. Got '%v'", 1, order[0])
+	}
+
+	for i := 1; i < len(order); i++ {
+		gap := order[i] - order[i-1] - 1
+		if gap < 0 || gap > maxGap {
+			t.Fatalf("Expected gap between values to be between 0 and %v. Got %v in '%v'", maxGap, gap, order)
+		}
+	}
+}
+
+func TestGeneratorGenerateWithHeight(t *testing.T) {
+	tt := []struct {
+		name     string
+		length   int
+		height   int
+		expected bool
+	}{
+		{"empty tree", 0, 0, true},
+		{"only root", 1, 1, true},
+		{"complete tree", 7, 3, true},
+		{"degenerated tree", 7, 7, true},
+		{"intermediate height", 100, 12, true},
+		{"height lower than complete tree", 8, 3, false},
+		{"height greater than length", 5, 6, false},
+		{"negative length", -1, 0, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			for seed := int64(0); seed < 20; seed++ {
+				bst, order, ok := binarytrees.NewSeededGenerator(seed).GenerateWithHeight(tc.length, tc.height)
+				if ok != tc.expected {
+					t.Fatalf("Expected ok to be '%v'. Got '%v'", tc.expected, ok)
+				}
+
+				if !ok {
+					return
+				}
+
+				if bst.Height() != tc.height {
+					t.Fatalf("Expected tree height to be %v. Got %v with order %v", tc.height, bst.Height(), order)
+				}
+
+				if bst.Len() != tc.length || len(order) != tc.length {
+					t.Fatalf("Expected Len value to be '%v'. Got '%v'", tc.length, bst.Len())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package binarytrees
+
+// LCA or Lowest Common Ancestor, returns the lowest BNode from root that has both given
+// values as descendants. Unlike BST.LCA it doesn't rely on the ordering of the values so
+// it works for any binary tree, as long as the values in the tree are unique.
+// Return nil if any of the values doesn't exist in the tree.
+func LCA(root *BNode, v1, v2 int) *BNode {
+	return LCAOf(root, v1, v2)
+}
+
+// LCAOf returns the lowest BNode from root that has all the given values as descendants.
+// The values in the tree are expected to be unique. Return nil if no values are given or
+// if any of them doesn't exist in the tree.
+func LCAOf(root *BNode, values ...int) *BNode {
+	targets := make(map[int]bool, len(values))
+	for _, v := range values {
+		targets[v] = true
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	lca, _ := findLCAOf(root, targets)
+	return lca
+}
+
+// findLCAOf returns the LCA of the targets if all of them are below node, otherwise it
+// returns the number of targets found below node.
+func findLCAOf(node *BNode, targets map[int]bool) (*BNode, int) {
+	if node == nil {
+		return nil, 0
+	}
+
+	lca, left := findLCAOf(node.Left, targets)
+	if lca != nil {
+		return lca, left
+	}
+	lca, right := findLCAOf(node.Right, targets)
+	if lca != nil {
+		return lca, right
+	}
+
+	found := left + right
+	if targets[node.Value] {
+		found++
+	}
+	if found == len(targets) {
+		return node, found
+	}
+	return nil, found
+}
+
+// LCAQuery is a pair of values whose Lowest Common Ancestor is requested.
+type LCAQuery struct {
+	V1, V2 int
+}
+
+// BatchLCA answers all the queries at once with the Tarjan's offline LCA algorithm, in near
+// linear time over the number of nodes and queries. The values in the tree are expected to
+// be unique. The result at position i is the LCA of queries[i] or nil if any of its values
+// doesn't exist in the tree.
+func BatchLCA(root *BNode, queries []LCAQuery) []*BNode {
+	t := &tarjanLCA{
+		queries: queries,
+		byValue: make(map[int][]int),
+		visited: make(map[int]int),
+		results: make([]*BNode, len(queries)),
+	}
+	for i, q := range queries {
+		t.byValue[q.V1] = append(t.byValue[q.V1], i)
+		if q.V2 != q.V1 {
+			t.byValue[q.V2] = append(t.byValue[q.V2], i)
+		}
+	}
+
+	t.visit(root)
+	return t.results
+}
+
+// tarjanLCA keeps the state of the Tarjan's offline LCA algorithm. Every visited node gets an
+// id used by the disjoint sets, where the ancestor of each set is the lowest node of the
+// current path that contains it.
+type tarjanLCA struct {
+	queries  []LCAQuery
+	byValue  map[int][]int
+	visited  map[int]int
+	parent   []int
+	rank     []int
+	ancestor []*BNode
+	results  []*BNode
+}
+
+func (t *tarjanLCA) visit(node *BNode) {
+	if node == nil {
+		return
+	}
+
+	id := len(t.parent)
+	t.parent = append(t.parent, id)
+	t.rank = append(t.rank, 0)
+	t.ancestor = append(t.ancestor, node)
+
+	for _, child := range []*BNode{node.Left, node.Right} {
+		if child == nil {
+			continue
+		}
+		childID := len(t.parent)
+		t.visit(child)
+		t.union(id, childID)
+		t.ancestor[t.find(id)] = node
+	}
+
+	t.visited[node.Value] = id
+	for _, q := range t.byValue[node.Value] {
+		other := t.queries[q].V1
+		if other == node.Value {
+			other = t.queries[q].V2
+		}
+		if otherID, ok := t.visited[other]; ok {
+			t.results[q] = t.ancestor[t.find(otherID)]
+		}
+	}
+}
+
+func (t *tarjanLCA) find(id int) int {
+	for t.parent[id] != id {
+		t.parent[id] = t.parent[t.parent[id]]
+		id = t.parent[id]
+	}
+	return id
+}
+
+// union joins the sets of x and y by rank, so together with the path halving of find the
+// operations take near constant amortized time.
+func (t *tarjanLCA) union(x, y int) {
+	x, y = t.find(x), t.find(y)
+	if x == y {
+		return
+	}
+
+	if t.rank[x] < t.rank[y] {
+		x, y = y, x
+	}
+	t.parent[y] = x
+	if t.rank[x] == t.rank[y] {
+		t.rank[x]++
+	}
+}
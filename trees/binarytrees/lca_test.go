@@ -0,0 +1,149 @@
+package binarytrees_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures/trees/binarytrees"
+)
+
+// plainTree returns a binary tree without ordering between the values
+//
+//	     1
+//	   /   \
+//	  2     3
+//	 / \     \
+//	4   5     6
+//	   / \
+//	  7   8
+func plainTree() *binarytrees.BNode {
+	return &binarytrees.BNode{
+		Value: 1,
+		Left: &binarytrees.BNode{
+			Value: 2,
+			Left:  binarytrees.NewBNode(4),
+			Right: &binarytrees.BNode{
+				Value: 5,
+				Left:  binarytrees.NewBNode(7),
+				Right: binarytrees.NewBNode(8),
+			},
+		},
+		Right: &binarytrees.BNode{
+			Value: 3,
+			Right: binarytrees.NewBNode(6),
+		},
+	}
+}
+
+func checkLCAResult(t *testing.T, expected int, result *binarytrees.BNode) {
+	if expected == 0 {
+		if result != nil {
+			t.Fatalf("Expected LCA to be nil. Got '%v'", result.Value)
+		}
+		return
+	}
+
+	if result == nil {
+		t.Fatalf("Expected LCA to be '%v'. Got nil", expected)
+	}
+
+	if result.Value != expected {
+		t.Errorf("Expected LCA to be '%v'. Got '%v'", expected, result.Value)
+	}
+}
+
+func TestLCA(t *testing.T) {
+	root := plainTree()
+
+	tt := []struct {
+		name     string
+		v1, v2   int
+		expected int
+	}{
+		{"siblings", 7, 8, 5},
+		{"different depths", 4, 8, 2},
+		{"root LCA", 7, 6, 1},
+		{"ancestor and descendant", 2, 7, 2},
+		{"same value", 6, 6, 6},
+		{"missing value", 7, 10, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			checkLCAResult(t, tc.expected, binarytrees.LCA(root, tc.v1, tc.v2))
+		})
+	}
+}
+
+func TestLCANilRoot(t *testing.T) {
+	if result := binarytrees.LCA(nil, 1, 2); result != nil {
+		t.Errorf("Expected LCA to be nil. Got '%v'", result)
+	}
+}
+
+func TestLCAOf(t *testing.T) {
+	root := plainTree()
+
+	tt := []struct {
+		name     string
+		values   []int
+		expected int
+	}{
+		{"single value", []int{5}, 5},
+		{"three values into a branch", []int{4, 7, 8}, 2},
+		{"values from both branches", []int{7, 4, 6}, 1},
+		{"duplicate values", []int{7, 7, 8}, 5},
+		{"missing value", []int{4, 7, 10}, 0},
+		{"no values", []int{}, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			checkLCAResult(t, tc.expected, binarytrees.LCAOf(root, tc.values...))
+		})
+	}
+}
+
+func TestBatchLCA(t *testing.T) {
+	queries := []binarytrees.LCAQuery{
+		{V1: 7, V2: 8}, {V1: 4, V2: 8}, {V1: 7, V2: 6}, {V1: 2, V2: 7},
+		{V1: 7, V2: 2}, {V1: 6, V2: 6}, {V1: 7, V2: 10}, {V1: 10, V2: 10},
+	}
+	expected := []int{5, 2, 1, 2, 2, 6, 0, 0}
+
+	result := binarytrees.BatchLCA(plainTree(), queries)
+	if len(result) != len(queries) {
+		t.Fatalf("Expected %v results. Got %v", len(queries), len(result))
+	}
+
+	for i := range queries {
+		checkLCAResult(t, expected[i], result[i])
+	}
+}
+
+func TestBatchLCAMatchesLCA(t *testing.T) {
+	for _, d := range []binarytrees.Distribution{binarytrees.Uniform, binarytrees.Sorted, binarytrees.ZigZag} {
+		t.Run(fmt.Sprintf("distribution %v", d), func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			bst, _ := binarytrees.NewGenerator(r).Generate(200, d)
+
+			queries := make([]binarytrees.LCAQuery, 1000)
+			for i := range queries {
+				queries[i] = binarytrees.LCAQuery{V1: 1 + r.Intn(210), V2: 1 + r.Intn(210)}
+			}
+
+			result := binarytrees.BatchLCA(bst.Root(), queries)
+			for i, q := range queries {
+				expected := bst.LCA(q.V1, q.V2)
+				if result[i] != expected {
+					t.Fatalf("Expected LCA of %v to be '%v'. Got '%v'", q, expected, result[i])
+				}
+
+				if plain := binarytrees.LCA(bst.Root(), q.V1, q.V2); plain != expected {
+					t.Fatalf("Expected LCA of %v to be '%v'. Got '%v'", q, expected, plain)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package binarytrees
+
+import (
+	"math"
+)
+
+// SplayTree is a self-adjusting binary search tree. Every access splays the accessed node,
+// moving it to the root with rotations, so the recently accessed values are the cheapest
+// to reach and the operations take O(log n) amortized time.
+//
+// Search, Has, Min and Max splay the tree, so they modify it like Insert and Remove. Use
+// Peek to look up a value without changing the tree.
+//
+// Read/Write operations are not safe for concurrent mutation by multiple
+// goroutines, including the lookups that splay.
+type SplayTree struct {
+	root   *BNode
+	length int
+}
+
+// NewSplayTree build an empty SplayTree.
+func NewSplayTree() *SplayTree {
+	return &SplayTree{}
+}
+
+// Root returns the root node of the tree.
+func (t *SplayTree) Root() *BNode {
+	return t.root
+}
+
+// Len returns the number of items currently in the tree.
+func (t *SplayTree) Len() int {
+	return t.length
+}
+
+// splay moves to the root the node with the value, or the last node visited looking for it
+// if it's not in the tree, using the top-down splay of Sleator and Tarjan.
+func splay(root *BNode, value int) *BNode {
+	if root == nil {
+		return nil
+	}
+
+	// header.Right holds the tree of the nodes lower than value and header.Left the tree of
+	// the greater ones, left and right are the nodes where the next ones are linked.
+	var header BNode
+	left, right := &header, &header
+	node := root
+	for node.Value != value {
+		if value < node.Value {
+			if node.Left == nil {
+				break
+			}
+			if value < node.Left.Value {
+				node = rotateRight(node)
+				if node.Left == nil {
+					break
+				}
+			}
+			right.Left = node
+			right = node
+			node = node.Left
+		} else {
+			if node.Right == nil {
+				break
+			}
+			if value > node.Right.Value {
+				node = rotateLeft(node)
+				if node.Right == nil {
+					break
+				}
+			}
+			left.Right = node
+			left = node
+			node = node.Right
+		}
+	}
+
+	left.Right, right.Left = node.Left, node.Right
+	node.Left, node.Right = header.Right, header.Left
+	return node
+}
+
+func rotateRight(node *BNode) *BNode {
+	left := node.Left
+	node.Left, left.Right = left.Right, node
+	return left
+}
+
+func rotateLeft(node *BNode) *BNode {
+	right := node.Right
+	node.Right, right.Left = right.Left, node
+	return right
+}
+
+// Insert insert an item in the tree as its new root. Return true if the value was inserted and false otherwise
+func (t *SplayTree) Insert(value int) bool {
+	node := NewBNode(value)
+	if t.root == nil {
+		t.root = node
+		t.length++
+		return true
+	}
+
+	t.root = splay(t.root, value)
+	if t.root.Value == value {
+		return false
+	}
+
+	// the root is the closest value, so it and one of its subtrees go at the other side
+	if value < t.root.Value {
+		node.Left, node.Right = t.root.Left, t.root
+		t.root.Left = nil
+	} else {
+		node.Left, node.Right = t.root, t.root.Right
+		t.root.Right = nil
+	}
+	t.root = node
+	t.length++
+	return true
+}
+
+// Remove remove an item from the tree. Return true if the value was removed and false otherwise.
+func (t *SplayTree) Remove(value int) bool {
+	t.root = splay(t.root, value)
+	if t.root == nil || t.root.Value != value {
+		return false
+	}
+
+	if t.root.Left == nil {
+		t.root = t.root.Right
+	} else {
+		// the max of the left subtree becomes the root, it has no right child to hold the right subtree
+		right := t.root.Right
+		t.root = splay(t.root.Left, value)
+		t.root.Right = right
+	}
+	t.length--
+	return true
+}
+
+// Search returns the node if the value exists in the tree, splaying the tree so the node,
+// or the last one visited looking for it if it's not found, becomes the root.
+func (t *SplayTree) Search(value int) *BNode {
+	t.root = splay(t.root, value)
+	if t.root == nil || t.root.Value != value {
+		return nil
+	}
+	return t.root
+}
+
+// Has returns true if if the value exists in the tree. It splays the tree like Search.
+func (t *SplayTree) Has(value int) bool {
+	return t.Search(value) != nil
+}
+
+// Peek returns the node if the value exists in the tree, without splaying the tree.
+func (t *SplayTree) Peek(value int) *BNode {
+	return searchNode(t.root, value)
+}
+
+// Min returns the node with minimal value stored in the tree, splaying it to the root.
+func (t *SplayTree) Min() *BNode {
+	t.root = splay(t.root, math.MinInt)
+	return t.root
+}
+
+// Max returns the node with maximum value stored in the tree, splaying it to the root.
+func (t *SplayTree) Max() *BNode {
+	t.root = splay(t.root, math.MaxInt)
+	return t.root
+}
+
+// InOrderTraverse visits all the nodes in order
+func (t *SplayTree) InOrderTraverse(f func(int)) {
+	inOrderTraverse(t.root, f)
+}
+
+// PreOrderTraverse visits all the nodes in pre order
+func (t *SplayTree) PreOrderTraverse(f func(int)) {
+	preOrderTraverse(t.root, f)
+}
+
+// PostOrderTraverse visits all the nodes in post order
+func (t *SplayTree) PostOrderTraverse(f func(int)) {
+	postOrderTraverse(t.root, f)
+}
+
+// BreadthFirstTraverse visits all the nodes by levels from top to bottom and from left to right.
+func (t *SplayTree) BreadthFirstTraverse(f func(int)) {
+	breadthFirstTraverse(t.root, f)
+}
+
+// Height return the height of a tree
+func (t *SplayTree) Height() int {
+	return nodeHeight(t.root)
+}
+
+// Validate returns true if every node of the tree keeps the binary search tree ordering
+// and the number of nodes matches Len.
+func (t *SplayTree) Validate() bool {
+	count, valid := validateNode(t.root, nil, nil)
+	return valid && count == t.length
+}
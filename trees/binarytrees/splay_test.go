@@ -0,0 +1,282 @@
+package binarytrees_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures/trees/binarytrees"
+)
+
+func newSplayTreeWithValues(values ...int) *binarytrees.SplayTree {
+	tree := binarytrees.NewSplayTree()
+	for _, v := range values {
+		tree.Insert(v)
+	}
+	return tree
+}
+
+func splayTreeValues(tree *binarytrees.SplayTree) []int {
+	result := []int{}
+	tree.InOrderTraverse(func(i int) {
+		result = append(result, i)
+	})
+	return result
+}
+
+func TestSplayTreeInsert(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+		expected     []int
+	}{
+		{"empty tree", []int{}, []int{}},
+		{"unordered values", []int{5, 1, 9, 3, 7}, []int{1, 3, 5, 7, 9}},
+		{"duplicated values", []int{2, 2, 1, 2}, []int{1, 2}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			tree := newSplayTreeWithValues(tc.insertValues...)
+
+			if result := splayTreeValues(tree); !equalInts(result, tc.expected) {
+				t.Errorf("Expected in order traversal to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if !tree.Validate() || tree.Len() != len(tc.expected) {
+				t.Errorf("Expected tree to be valid with len '%v'. Got '%v'", len(tc.expected), tree.Len())
+			}
+
+			if n := len(tc.insertValues); n > 0 && tree.Root().Value != tc.insertValues[n-1] {
+				t.Errorf("Expected root to be the last inserted value '%v'. Got '%v'", tc.insertValues[n-1], tree.Root().Value)
+			}
+		})
+	}
+}
+
+func TestSplayTreeSearch(t *testing.T) {
+	tt := []struct {
+		name         string
+		value        int
+		expected     bool
+		expectedRoot int
+	}{
+		{"existing leaf", 1, true, 1},
+		{"existing inner value", 6, true, 6},
+		{"missing value splays the last visited one", 7, false, 8},
+		{"value greater than max", 100, false, 10},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			tree := newSplayTreeWithValues(8, 3, 10, 1, 6, 4)
+
+			node := tree.Search(tc.value)
+			if (node != nil) != tc.expected {
+				t.Errorf("Expected search to find the value to be '%v'. Got '%v'", tc.expected, node)
+			}
+
+			if tree.Root().Value != tc.expectedRoot {
+				t.Errorf("Expected root after search to be '%v'. Got '%v'", tc.expectedRoot, tree.Root().Value)
+			}
+
+			if tree.Has(tc.value) != tc.expected {
+				t.Errorf("Expected has to be '%v'. Got '%v'", tc.expected, !tc.expected)
+			}
+
+			if !tree.Validate() {
+				t.Error("Expected tree to be valid")
+			}
+		})
+	}
+}
+
+func TestSplayTreePeekDoesNotSplay(t *testing.T) {
+	tree := newSplayTreeWithValues(8, 3, 10, 1, 6, 4)
+	var before []int
+	tree.PreOrderTraverse(func(i int) {
+		before = append(before, i)
+	})
+
+	if node := tree.Peek(1); node == nil || node.Value != 1 {
+		t.Errorf("Expected peek to find '%v'. Got '%v'", 1, node)
+	}
+
+	if node := tree.Peek(7); node != nil {
+		t.Errorf("Expected peek of a missing value to be nil. Got '%v'", node)
+	}
+
+	var after []int
+	tree.PreOrderTraverse(func(i int) {
+		after = append(after, i)
+	})
+	if !equalInts(before, after) {
+		t.Errorf("Expected peek to keep the tree shape '%v'. Got '%v'", before, after)
+	}
+}
+
+func TestSplayTreeRemove(t *testing.T) {
+	tt := []struct {
+		name     string
+		value    int
+		removed  bool
+		expected []int
+	}{
+		{"inner value", 4, true, []int{1, 2, 3, 5, 6, 7}},
+		{"min value", 1, true, []int{2, 3, 4, 5, 6, 7}},
+		{"max value", 7, true, []int{1, 2, 3, 4, 5, 6}},
+		{"missing value", 8, false, []int{1, 2, 3, 4, 5, 6, 7}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			tree := newSplayTreeWithValues(4, 2, 6, 1, 3, 5, 7)
+
+			if result := tree.Remove(tc.value); result != tc.removed {
+				t.Errorf("Expected remove to be '%v'. Got '%v'", tc.removed, result)
+			}
+
+			if result := splayTreeValues(tree); !equalInts(result, tc.expected) {
+				t.Errorf("Expected in order traversal to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if !tree.Validate() {
+				t.Error("Expected tree to be valid")
+			}
+		})
+	}
+
+	if binarytrees.NewSplayTree().Remove(1) {
+		t.Error("Expected remove from an empty tree to be false")
+	}
+}
+
+func TestSplayTreeMinMax(t *testing.T) {
+	tree := binarytrees.NewSplayTree()
+	if tree.Min() != nil || tree.Max() != nil {
+		t.Error("Expected min and max of an empty tree to be nil")
+	}
+
+	tree = newSplayTreeWithValues(5, -3, 9, 0)
+	if min := tree.Min(); min.Value != -3 || tree.Root() != min {
+		t.Errorf("Expected min to be the root '-3'. Got '%v'", min.Value)
+	}
+
+	if max := tree.Max(); max.Value != 9 || tree.Root() != max {
+		t.Errorf("Expected max to be the root '9'. Got '%v'", max.Value)
+	}
+}
+
+func TestSplayTreeTraversals(t *testing.T) {
+	tree := binarytrees.NewSplayTree()
+	// inserting in ascending order leaves a tree (linked list) to left
+	for _, v := range []int{1, 2, 3} {
+		tree.Insert(v)
+	}
+
+	tt := []struct {
+		name     string
+		traverse func(f func(int))
+		expected []int
+	}{
+		{"pre order", tree.PreOrderTraverse, []int{3, 2, 1}},
+		{"post order", tree.PostOrderTraverse, []int{1, 2, 3}},
+		{"breadth first", tree.BreadthFirstTraverse, []int{3, 2, 1}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := []int{}
+			tc.traverse(func(i int) {
+				result = append(result, i)
+			})
+
+			if !equalInts(result, tc.expected) {
+				t.Errorf("Expected traversal to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+
+	if tree.Height() != 3 {
+		t.Errorf("Expected tree height to be '%v'. Got '%v'", 3, tree.Height())
+	}
+}
+
+func TestSplayTreeRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree := binarytrees.NewSplayTree()
+	model := make(map[int]bool)
+
+	for step := 0; step < 5000; step++ {
+		v := r.Intn(300)
+		switch op := r.Intn(10); {
+		case op < 5:
+			if tree.Insert(v) == model[v] {
+				t.Fatalf("Expected insert of '%v' to be '%v'", v, !model[v])
+			}
+			model[v] = true
+		case op < 8:
+			if tree.Remove(v) != model[v] {
+				t.Fatalf("Expected remove of '%v' to be '%v'", v, model[v])
+			}
+			delete(model, v)
+		default:
+			if tree.Has(v) != model[v] {
+				t.Fatalf("Expected has of '%v' to be '%v'", v, model[v])
+			}
+		}
+
+		if !tree.Validate() {
+			t.Fatalf("Expected tree to be valid after step '%v'", step)
+		}
+	}
+
+	expected := []int{}
+	for v := range model {
+		expected = append(expected, v)
+	}
+	sort.Ints(expected)
+	if result := splayTreeValues(tree); !equalInts(result, expected) {
+		t.Errorf("Expected in order traversal to be '%v'. Got '%v'", expected, result)
+	}
+}
+
+// zipfianKeys returns lookups over the keys in [0, n) following a Zipf distribution with
+// parameter s, where the popular keys are spread at random over the key space.
+func zipfianKeys(n, lookups int, s float64) []int {
+	r := rand.New(rand.NewSource(1))
+	ranks := r.Perm(n)
+	zipf := rand.NewZipf(r, s, 1, uint64(n-1))
+	keys := make([]int, lookups)
+	for i := range keys {
+		keys[i] = ranks[zipf.Uint64()]
+	}
+	return keys
+}
+
+func BenchmarkZipfianSearch(b *testing.B) {
+	const n = 1 << 16
+	insertOrder := rand.New(rand.NewSource(2)).Perm(n)
+
+	for _, s := range []float64{1.01, 1.2, 2} {
+		keys := zipfianKeys(n, 1<<16, s)
+
+		b.Run(fmt.Sprintf("s=%v/bst", s), func(b *testing.B) {
+			bst := binarytrees.New(insertOrder[0])
+			fillTreeWithList(bst, insertOrder[1:])
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				bst.Search(keys[i%len(keys)])
+			}
+		})
+
+		b.Run(fmt.Sprintf("s=%v/splay", s), func(b *testing.B) {
+			tree := newSplayTreeWithValues(insertOrder...)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				tree.Search(keys[i%len(keys)])
+			}
+		})
+	}
+}
@@ -0,0 +1,429 @@
+package binarytrees
+
+import (
+	"io"
+	"math/rand"
+	"time"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+// PrioritySource gives the random priorities of the nodes of a Treap. *rand.Rand and
+// rand.Source implement it.
+type PrioritySource interface {
+	Int63() int64
+}
+
+// TNode is a single node that compose a Treap.
+type TNode struct {
+	Value    int
+	Priority int64
+	Left     *TNode
+	Right    *TNode
+	// size is the number of nodes of the subtree rooted at the node.
+	size int
+}
+
+func (n *TNode) update() *TNode {
+	n.size = treapSize(n.Left) + treapSize(n.Right) + 1
+	return n
+}
+
+func treapSize(node *TNode) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+// Treap is a randomized balanced binary search tree. Every node has a random priority and
+// the tree is a heap of priorities at the same time that a BST of values, so it's balanced
+// in probability with O(log n) expected height regardless of the insertion order. It has the
+// methods of BST over TNodes, use ToBNode to run the package functions over BNodes with it.
+//
+// Its core operations are Split and Merge, which cut a tree at a value and join two trees
+// whose values don't overlap in O(log n) expected time, so DeleteRange is O(log n) too.
+//
+// The zero value is an empty Treap that takes the priorities from a source seeded with the
+// current time.
+//
+// Read/Write operations are not safe for concurrent mutation by multiple
+// goroutines.
+type Treap struct {
+	root       *TNode
+	priorities PrioritySource
+}
+
+// NewTreap build an empty Treap that takes the priorities from source. If source is nil
+// a source seeded with the current time is used.
+func NewTreap(source PrioritySource) *Treap {
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+	return &Treap{priorities: source}
+}
+
+// NewSeededTreap build an empty Treap backed by a new source initialized with seed, so the
+// same insertions always produce the same tree.
+func NewSeededTreap(seed int64) *Treap {
+	return NewTreap(rand.NewSource(seed))
+}
+
+// source returns the priority source, creating it for the zero value.
+func (t *Treap) source() PrioritySource {
+	if t.priorities == nil {
+		t.priorities = rand.NewSource(time.Now().UnixNano())
+	}
+	return t.priorities
+}
+
+// Root returns the root node of the tree.
+func (t *Treap) Root() *TNode {
+	return t.root
+}
+
+// Len returns the number of items currently in the tree.
+func (t *Treap) Len() int {
+	return treapSize(t.root)
+}
+
+// splitTNode splits the tree rooted at node into the values that goes to the left and the rest.
+// goesLeft must be true for a prefix of the values in order.
+func splitTNode(node *TNode, goesLeft func(int) bool) (*TNode, *TNode) {
+	if node == nil {
+		return nil, nil
+	}
+
+	if goesLeft(node.Value) {
+		var right *TNode
+		node.Right, right = splitTNode(node.Right, goesLeft)
+		return node.update(), right
+	}
+	var left *TNode
+	left, node.Left = splitTNode(node.Left, goesLeft)
+	return left, node.update()
+}
+
+// mergeTNode joins two trees where every value of left is lower than every value of right.
+func mergeTNode(left, right *TNode) *TNode {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+
+	if left.Priority > right.Priority {
+		left.Right = mergeTNode(left.Right, right)
+		return left.update()
+	}
+	right.Left = mergeTNode(left, right.Left)
+	return right.update()
+}
+
+// Insert insert an item in the right position in the tree. Return true if the value was inserted and false otherwise
+func (t *Treap) Insert(value int) bool {
+	if t.Has(value) {
+		return false
+	}
+
+	node := &TNode{Value: value, Priority: t.source().Int63(), size: 1}
+	left, right := splitTNode(t.root, func(v int) bool { return v < value })
+	t.root = mergeTNode(mergeTNode(left, node), right)
+	return true
+}
+
+// Remove remove an item from the tree. Return true if the value was removed and false otherwise.
+func (t *Treap) Remove(value int) bool {
+	var removed bool
+	t.root, removed = removeTNode(t.root, value)
+	return removed
+}
+
+func removeTNode(node *TNode, value int) (*TNode, bool) {
+	if node == nil {
+		return nil, false
+	}
+
+	var removed bool
+	switch {
+	case value < node.Value:
+		node.Left, removed = removeTNode(node.Left, value)
+	case value > node.Value:
+		node.Right, removed = removeTNode(node.Right, value)
+	default:
+		return mergeTNode(node.Left, node.Right), true
+	}
+	return node.update(), removed
+}
+
+// Split moves the values greater or equal than k to a new Treap that is returned, leaving
+// in the tree only the lower ones. Both trees share the priority source.
+func (t *Treap) Split(k int) *Treap {
+	var right *TNode
+	t.root, right = splitTNode(t.root, func(v int) bool { return v < k })
+	return &Treap{root: right, priorities: t.source()}
+}
+
+// Merge moves every value of other to the tree, leaving other empty. Every value of the tree
+// must be lower than every value of other, otherwise it returns false and nothing is moved.
+func (t *Treap) Merge(other *Treap) bool {
+	if t.root != nil && other.root != nil && t.Max().Value >= other.Min().Value {
+		return false
+	}
+
+	t.root = mergeTNode(t.root, other.root)
+	other.root = nil
+	return true
+}
+
+// DeleteRange removes the values between lo and hi, both included. Return the number of
+// values removed.
+func (t *Treap) DeleteRange(lo, hi int) int {
+	if lo > hi {
+		return 0
+	}
+
+	left, rest := splitTNode(t.root, func(v int) bool { return v < lo })
+	middle, right := splitTNode(rest, func(v int) bool { return v <= hi })
+	t.root = mergeTNode(left, right)
+	return treapSize(middle)
+}
+
+// Search returns the node if the value exists in the tree
+func (t *Treap) Search(value int) *TNode {
+	return searchTNode(t.root, value)
+}
+
+func searchTNode(node *TNode, value int) *TNode {
+	for node != nil && node.Value != value {
+		if value < node.Value {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return node
+}
+
+// Has returns true if if the value exists in the tree
+func (t *Treap) Has(value int) bool {
+	return t.Search(value) != nil
+}
+
+// Min returns the node with minimal value stored in the tree
+func (t *Treap) Min() *TNode {
+	node := t.root
+	for node != nil && node.Left != nil {
+		node = node.Left
+	}
+	return node
+}
+
+// Max returns the node with maximum value stored in the tree
+func (t *Treap) Max() *TNode {
+	node := t.root
+	for node != nil && node.Right != nil {
+		node = node.Right
+	}
+	return node
+}
+
+// InOrderTraverse visits all the nodes in order
+func (t *Treap) InOrderTraverse(f func(int)) {
+	inOrderTraverseTNode(t.root, f)
+}
+
+func inOrderTraverseTNode(node *TNode, f func(int)) {
+	if node == nil {
+		return
+	}
+
+	inOrderTraverseTNode(node.Left, f)
+	f(node.Value)
+	inOrderTraverseTNode(node.Right, f)
+}
+
+// PreOrderTraverse visits all the nodes in pre order
+func (t *Treap) PreOrderTraverse(f func(int)) {
+	preOrderTraverseTNode(t.root, f)
+}
+
+func preOrderTraverseTNode(node *TNode, f func(int)) {
+	if node == nil {
+		return
+	}
+
+	f(node.Value)
+	preOrderTraverseTNode(node.Left, f)
+	preOrderTraverseTNode(node.Right, f)
+}
+
+// PostOrderTraverse visits all the nodes in post order
+func (t *Treap) PostOrderTraverse(f func(int)) {
+	postOrderTraverseTNode(t.root, f)
+}
+
+func postOrderTraverseTNode(node *TNode, f func(int)) {
+	if node == nil {
+		return
+	}
+
+	postOrderTraverseTNode(node.Left, f)
+	postOrderTraverseTNode(node.Right, f)
+	f(node.Value)
+}
+
+// BreadthFirstTraverse visits all the nodes by levels from top to bottom and from left to right.
+func (t *Treap) BreadthFirstTraverse(f func(int)) {
+	if t.root == nil {
+		return
+	}
+
+	queue := gostrutures.TypedQueue[*TNode]{}
+	queue.Push(t.root)
+	for !queue.IsEmpty() {
+		node, _ := queue.Pop()
+		f(node.Value)
+		if node.Left != nil {
+			queue.Push(node.Left)
+		}
+		if node.Right != nil {
+			queue.Push(node.Right)
+		}
+	}
+}
+
+// Height return the height of a tree
+func (t *Treap) Height() int {
+	return tNodeHeight(t.root)
+}
+
+func tNodeHeight(node *TNode) int {
+	if node == nil {
+		return 0
+	}
+
+	return intMax(tNodeHeight(node.Left), tNodeHeight(node.Right)) + 1
+}
+
+// LCA or Lowest Common Ancestor,
+// returns the lowest TNode in the Treap that has both given values as descendants.
+func (t *Treap) LCA(v1, v2 int) *TNode {
+	node := t.root
+	for node != nil {
+		if node.Value > v1 && node.Value > v2 {
+			node = node.Left
+		} else if node.Value < v1 && node.Value < v2 {
+			node = node.Right
+		} else if searchTNode(node, v1) != nil && searchTNode(node, v2) != nil {
+			return node
+		} else {
+			return nil
+		}
+	}
+	return nil
+}
+
+// PathTo returns the values of the nodes from the root to the node with the given value.
+// Return false if the value doesn't exist in the tree.
+func (t *Treap) PathTo(value int) ([]int, bool) {
+	return pathToTNode(t.root, value)
+}
+
+func pathToTNode(node *TNode, value int) ([]int, bool) {
+	var path []int
+	for node != nil {
+		path = append(path, node.Value)
+		if node.Value == value {
+			return path, true
+		}
+
+		if node.Value > value {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return nil, false
+}
+
+// AncestorsOf returns the values of the ancestors of the node with the given value, from
+// the root to its parent. Return false if the value doesn't exist in the tree.
+func (t *Treap) AncestorsOf(value int) ([]int, bool) {
+	path, found := t.PathTo(value)
+	if !found {
+		return nil, false
+	}
+	return path[:len(path)-1], true
+}
+
+// Depth returns the number of edges from the root to the node with the given value.
+// Return false if the value doesn't exist in the tree.
+func (t *Treap) Depth(value int) (int, bool) {
+	path, found := t.PathTo(value)
+	return len(path) - 1, found
+}
+
+// Distance returns the number of edges between the nodes with the given values, going
+// through their LCA. Return false if any of the values doesn't exist in the tree.
+func (t *Treap) Distance(v1, v2 int) (int, bool) {
+	lca := t.LCA(v1, v2)
+	if lca == nil {
+		return -1, false
+	}
+
+	path1, _ := pathToTNode(lca, v1)
+	path2, _ := pathToTNode(lca, v2)
+	return len(path1) - 1 + len(path2) - 1, true
+}
+
+// Print prints a visual representation of the treap into an io.Writer
+func (t *Treap) Print(w io.Writer) {
+	PrintTreeFromNode(w, t.ToBNode(), 0)
+}
+
+// PrintByLevel prints a visual representation of the treap by level into an io.Writer
+func (t *Treap) PrintByLevel(w io.Writer) {
+	PrintTreeByLevel(w, t.ToBNode())
+}
+
+// ToBNode returns a copy of the tree made of BNodes with the same shape, so the functions
+// over binary trees of the package, like LCA, BatchLCA or Diameter, can be used with it.
+func (t *Treap) ToBNode() *BNode {
+	return tNodeToBNode(t.root)
+}
+
+func tNodeToBNode(node *TNode) *BNode {
+	if node == nil {
+		return nil
+	}
+
+	return &BNode{Value: node.Value, Left: tNodeToBNode(node.Left), Right: tNodeToBNode(node.Right)}
+}
+
+// Validate returns true if every node of the tree keeps the binary search tree ordering,
+// no child has a greater priority than its parent and the sizes of the subtrees are right.
+func (t *Treap) Validate() bool {
+	return validateTNode(t.root, nil, nil)
+}
+
+func validateTNode(node *TNode, min, max *int) bool {
+	if node == nil {
+		return true
+	}
+
+	if (min != nil && node.Value <= *min) || (max != nil && node.Value >= *max) {
+		return false
+	}
+
+	for _, child := range []*TNode{node.Left, node.Right} {
+		if child != nil && child.Priority > node.Priority {
+			return false
+		}
+	}
+
+	return node.size == treapSize(node.Left)+treapSize(node.Right)+1 &&
+		validateTNode(node.Left, min, &node.Value) &&
+		validateTNode(node.Right, &node.Value, max)
+}
@@ -0,0 +1,514 @@
+package binarytrees_test
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures/trees/binarytrees"
+)
+
+func treapValues(t *binarytrees.Treap) []int {
+	result := []int{}
+	t.InOrderTraverse(func(i int) {
+		result = append(result, i)
+	})
+	return result
+}
+
+func newTreapWithValues(values ...int) *binarytrees.Treap {
+	treap := binarytrees.NewSeededTreap(1)
+	for _, v := range values {
+		treap.Insert(v)
+	}
+	return treap
+}
+
+func TestTreapInsert(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+		expected     []int
+	}{
+		{"empty tree", []int{}, []int{}},
+		{"unordered values", []int{5, 1, 9, 3, 7}, []int{1, 3, 5, 7, 9}},
+		{"duplicated values", []int{2, 2, 1, 2}, []int{1, 2}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			treap := newTreapWithValues(tc.insertValues...)
+
+			if result := treapValues(treap); !equalInts(result, tc.expected) {
+				t.Errorf("Expected in order traversal to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if treap.Len() != len(tc.expected) {
+				t.Errorf("Expected tree len to be '%v'. Got '%v'", len(tc.expected), treap.Len())
+			}
+
+			if !treap.Validate() {
+				t.Error("Expected tree to be valid")
+			}
+		})
+	}
+}
+
+func TestTreapInsertDuplicated(t *testing.T) {
+	treap := newTreapWithValues(1)
+	if treap.Insert(1) {
+		t.Error("Expected insert of a duplicated value to be false")
+	}
+}
+
+func TestTreapZeroValue(t *testing.T) {
+	var treap binarytrees.Treap
+	for _, v := range []int{3, 1, 2} {
+		if !treap.Insert(v) {
+			t.Errorf("Expected insert of '%v' to be true", v)
+		}
+	}
+
+	right := treap.Split(2)
+	right.Insert(4)
+	if result := treapValues(right); !equalInts(result, []int{2, 3, 4}) {
+		t.Errorf("Expected right values to be '%v'. Got '%v'", []int{2, 3, 4}, result)
+	}
+
+	if !treap.Validate() || !right.Validate() {
+		t.Error("Expected both trees to be valid")
+	}
+
+	var empty binarytrees.Treap
+	if !empty.Split(0).Insert(1) {
+		t.Error("Expected insert into a tree split from a zero value to be true")
+	}
+}
+
+func TestTreapRemove(t *testing.T) {
+	tt := []struct {
+		name     string
+		value    int
+		removed  bool
+		expected []int
+	}{
+		{"root or inner value", 4, true, []int{1, 2, 3, 5, 6, 7}},
+		{"min value", 1, true, []int{2, 3, 4, 5, 6, 7}},
+		{"max value", 7, true, []int{1, 2, 3, 4, 5, 6}},
+		{"missing value", 8, false, []int{1, 2, 3, 4, 5, 6, 7}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			treap := newTreapWithValues(4, 2, 6, 1, 3, 5, 7)
+
+			if result := treap.Remove(tc.value); result != tc.removed {
+				t.Errorf("Expected remove to be '%v'. Got '%v'", tc.removed, result)
+			}
+
+			if result := treapValues(treap); !equalInts(result, tc.expected) {
+				t.Errorf("Expected in order traversal to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if !treap.Validate() || treap.Len() != len(tc.expected) {
+				t.Errorf("Expected tree to be valid with len '%v'. Got '%v'", len(tc.expected), treap.Len())
+			}
+		})
+	}
+}
+
+func TestTreapSearchMinMax(t *testing.T) {
+	treap := newTreapWithValues()
+	if treap.Min() != nil || treap.Max() != nil || treap.Root() != nil {
+		t.Error("Expected min, max and root of an empty tree to be nil")
+	}
+
+	treap = newTreapWithValues(8, 3, 10, 1, 6)
+	if treap.Min().Value != 1 || treap.Max().Value != 10 {
+		t.Errorf("Expected min and max to be '1, 10'. Got '%v, %v'", treap.Min().Value, treap.Max().Value)
+	}
+
+	if node := treap.Search(6); node == nil || node.Value != 6 || !treap.Has(6) {
+		t.Errorf("Expected search to find '%v'. Got '%v'", 6, node)
+	}
+
+	if treap.Search(7) != nil || treap.Has(7) {
+		t.Error("Expected search of a missing value to be nil")
+	}
+}
+
+func TestTreapSplit(t *testing.T) {
+	tt := []struct {
+		name          string
+		k             int
+		expectedLeft  []int
+		expectedRight []int
+	}{
+		{"middle value", 4, []int{1, 2, 3}, []int{4, 5, 6}},
+		{"between values", 10, []int{1, 2, 3, 4, 5, 6}, []int{}},
+		{"lower than min", 0, []int{}, []int{1, 2, 3, 4, 5, 6}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			treap := newTreapWithValues(3, 6, 1, 5, 2, 4)
+			right := treap.Split(tc.k)
+
+			if result := treapValues(treap); !equalInts(result, tc.expectedLeft) {
+				t.Errorf("Expected left values to be '%v'. Got '%v'", tc.expectedLeft, result)
+			}
+
+			if result := treapValues(right); !equalInts(result, tc.expectedRight) {
+				t.Errorf("Expected right values to be '%v'. Got '%v'", tc.expectedRight, result)
+			}
+
+			if !treap.Validate() || !right.Validate() {
+				t.Error("Expected both trees to be valid")
+			}
+
+			// the new tree can keep growing
+			right.Insert(100)
+			if !right.Has(100) || !right.Validate() {
+				t.Error("Expected insert into the split tree to be valid")
+			}
+		})
+	}
+}
+
+func TestTreapMerge(t *testing.T) {
+	tt := []struct {
+		name      string
+		left      []int
+		right     []int
+		expected  bool
+		leftAfter []int
+	}{
+		{"ordered trees", []int{1, 2, 3}, []int{5, 4}, true, []int{1, 2, 3, 4, 5}},
+		{"empty left", []int{}, []int{2, 1}, true, []int{1, 2}},
+		{"empty right", []int{2, 1}, []int{}, true, []int{1, 2}},
+		{"overlapped trees", []int{1, 5}, []int{3, 7}, false, []int{1, 5}},
+		{"same boundary value", []int{1, 3}, []int{3, 4}, false, []int{1, 3}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			left, right := newTreapWithValues(tc.left...), newTreapWithValues(tc.right...)
+
+			if result := left.Merge(right); result != tc.expected {
+				t.Errorf("Expected merge to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if result := treapValues(left); !equalInts(result, tc.leftAfter) {
+				t.Errorf("Expected merged values to be '%v'. Got '%v'", tc.leftAfter, result)
+			}
+
+			if tc.expected && right.Len() != 0 {
+				t.Errorf("Expected merged tree to be empty. Got len '%v'", right.Len())
+			}
+
+			if !left.Validate() {
+				t.Error("Expected merged tree to be valid")
+			}
+		})
+	}
+}
+
+func TestTreapDeleteRange(t *testing.T) {
+	tt := []struct {
+		name            string
+		lo, hi          int
+		expectedRemoved int
+		expected        []int
+	}{
+		{"inner range", 3, 6, 4, []int{1, 2, 7, 8}},
+		{"bounds between values", 0, 2, 2, []int{3, 4, 5, 6, 7, 8}},
+		{"whole tree", -10, 10, 8, []int{}},
+		{"out of the tree", 20, 30, 0, []int{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"reversed bounds", 6, 3, 0, []int{1, 2, 3, 4, 5, 6, 7, 8}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			treap := newTreapWithValues(5, 1, 8, 3, 7, 2, 6, 4)
+
+			if removed := treap.DeleteRange(tc.lo, tc.hi); removed != tc.expectedRemoved {
+				t.Errorf("Expected removed values to be '%v'. Got '%v'", tc.expectedRemoved, removed)
+			}
+
+			if result := treapValues(treap); !equalInts(result, tc.expected) {
+				t.Errorf("Expected in order traversal to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if !treap.Validate() || treap.Len() != len(tc.expected) {
+				t.Errorf("Expected tree to be valid with len '%v'. Got '%v'", len(tc.expected), treap.Len())
+			}
+		})
+	}
+}
+
+func TestTreapTraversals(t *testing.T) {
+	// priorities in decreasing order make the tree shape follow the BST insertion order
+	source := &fixedPrioritySource{priorities: []int64{50, 40, 30, 20, 10}}
+	treap := binarytrees.NewTreap(source)
+	for _, v := range []int{4, 2, 6, 1, 3} {
+		treap.Insert(v)
+	}
+
+	tt := []struct {
+		name     string
+		traverse func(f func(int))
+		expected []int
+	}{
+		{"pre order", treap.PreOrderTraverse, []int{4, 2, 1, 3, 6}},
+		{"post order", treap.PostOrderTraverse, []int{1, 3, 2, 6, 4}},
+		{"breadth first", treap.BreadthFirstTraverse, []int{4, 2, 6, 1, 3}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := []int{}
+			tc.traverse(func(i int) {
+				result = append(result, i)
+			})
+
+			if !equalInts(result, tc.expected) {
+				t.Errorf("Expected traversal to be '%v'. Got '%v'", tc.expected, result)
+			}
+		})
+	}
+
+	if treap.Height() != 3 {
+		t.Errorf("Expected tree height to be '%v'. Got '%v'", 3, treap.Height())
+	}
+}
+
+// newTreapShapedLikeBST returns a treap with the shape of a BST with the values inserted in
+// order, as the priorities decrease with every insertion.
+func newTreapShapedLikeBST(values ...int) *binarytrees.Treap {
+	source := &fixedPrioritySource{}
+	for i := range values {
+		source.priorities = append(source.priorities, int64(len(values)-i))
+	}
+
+	treap := binarytrees.NewTreap(source)
+	for _, v := range values {
+		treap.Insert(v)
+	}
+	return treap
+}
+
+func TestTreapLCA(t *testing.T) {
+	treap := newTreapShapedLikeBST(5, 3, 1, 4, 7, 9, 6)
+
+	tt := []struct {
+		name     string
+		v1, v2   int
+		expected int
+		found    bool
+	}{
+		{"LCA into left branch of the tree", 1, 4, 3, true},
+		{"LCA into right branch of the tree", 9, 6, 7, true},
+		{"root LCA", 1, 9, 5, true},
+		{"value is the ancestor", 3, 4, 3, true},
+		{"missing value", 1, 10, 0, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := treap.LCA(tc.v1, tc.v2)
+			if !tc.found {
+				if result != nil {
+					t.Fatalf("Expected LCA to be nil. Got '%v'", result.Value)
+				}
+				return
+			}
+
+			if result == nil || result.Value != tc.expected {
+				t.Fatalf("Expected LCA to be '%v'. Got '%v'", tc.expected, result)
+			}
+
+			if lca := binarytrees.LCA(treap.ToBNode(), tc.v1, tc.v2); lca.Value != tc.expected {
+				t.Errorf("Expected LCA of the BNode copy to be '%v'. Got '%v'", tc.expected, lca.Value)
+			}
+		})
+	}
+}
+
+func TestTreapPaths(t *testing.T) {
+	treap := newTreapShapedLikeBST(5, 3, 1, 4, 7, 9, 6)
+
+	tt := []struct {
+		name              string
+		value             int
+		expectedPath      []int
+		expectedAncestors []int
+		expectedDepth     int
+		expectedFound     bool
+	}{
+		{"root", 5, []int{5}, []int{}, 0, true},
+		{"leaf into left branch", 4, []int{5, 3, 4}, []int{5, 3}, 2, true},
+		{"leaf into right branch", 6, []int{5, 7, 6}, []int{5, 7}, 2, true},
+		{"missing value", 8, []int{}, []int{}, -1, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if path, found := treap.PathTo(tc.value); !equalInts(path, tc.expectedPath) || found != tc.expectedFound {
+				t.Errorf("Expected path to be '%v, %v'. Got '%v, %v'", tc.expectedPath, tc.expectedFound, path, found)
+			}
+
+			if ancestors, found := treap.AncestorsOf(tc.value); !equalInts(ancestors, tc.expectedAncestors) || found != tc.expectedFound {
+				t.Errorf("Expected ancestors to be '%v, %v'. Got '%v, %v'", tc.expectedAncestors, tc.expectedFound, ancestors, found)
+			}
+
+			if depth, found := treap.Depth(tc.value); depth != tc.expectedDepth || found != tc.expectedFound {
+				t.Errorf("Expected depth to be '%v, %v'. Got '%v, %v'", tc.expectedDepth, tc.expectedFound, depth, found)
+			}
+		})
+	}
+}
+
+func TestTreapDistance(t *testing.T) {
+	treap := newTreapShapedLikeBST(5, 3, 1, 4, 7, 9, 6)
+
+	tt := []struct {
+		name          string
+		v1, v2        int
+		expected      int
+		expectedFound bool
+	}{
+		{"same value", 4, 4, 0, true},
+		{"parent and child", 3, 4, 1, true},
+		{"siblings", 1, 4, 2, true},
+		{"through the root", 4, 6, 4, true},
+		{"missing value", 4, 8, -1, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if result, found := treap.Distance(tc.v1, tc.v2); result != tc.expected || found != tc.expectedFound {
+				t.Errorf("Expected distance to be '%v, %v'. Got '%v, %v'", tc.expected, tc.expectedFound, result, found)
+			}
+		})
+	}
+}
+
+func TestTreapPrint(t *testing.T) {
+	treap := newTreapShapedLikeBST(2, 1, 3)
+	bst := binarytrees.New(2)
+	fillTreeWithList(bst, []int{1, 3})
+
+	for _, tc := range []struct {
+		name       string
+		treap, bst func(io.Writer)
+	}{
+		{"print", treap.Print, bst.Print},
+		{"print by level", treap.PrintByLevel, bst.PrintByLevel},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result, expected := new(bytes.Buffer), new(bytes.Buffer)
+			tc.treap(result)
+			tc.bst(expected)
+
+			if result.String() != expected.String() {
+				t.Errorf("Expected print to be:\n%v\nGot:\n%v", expected.String(), result.String())
+			}
+		})
+	}
+
+	buf := new(bytes.Buffer)
+	binarytrees.NewSeededTreap(1).Print(buf)
+	if buf.Len() != 0 {
+		t.Errorf("Expected print of an empty tree to be empty. Got '%v'", buf.String())
+	}
+}
+
+// fixedPrioritySource returns the given priorities in order.
+type fixedPrioritySource struct {
+	priorities []int64
+}
+
+func (s *fixedPrioritySource) Int63() int64 {
+	p := s.priorities[0]
+	s.priorities = s.priorities[1:]
+	return p
+}
+
+func TestTreapIsBalancedWithSortedInsertions(t *testing.T) {
+	treap := binarytrees.NewSeededTreap(1)
+	for v := 0; v < 10000; v++ {
+		treap.Insert(v)
+	}
+
+	// the expected height is around 3 ln(n), far from the n of a plain BST
+	if treap.Height() > 60 {
+		t.Errorf("Expected tree height to be logarithmic. Got '%v'", treap.Height())
+	}
+}
+
+func TestTreapSeededIsDeterministic(t *testing.T) {
+	shape := func() []int {
+		treap := binarytrees.NewSeededTreap(42)
+		for _, v := range rand.New(rand.NewSource(1)).Perm(100) {
+			treap.Insert(v)
+		}
+		result := []int{}
+		treap.PreOrderTraverse(func(i int) {
+			result = append(result, i)
+		})
+		return result
+	}
+
+	if a, b := shape(), shape(); !equalInts(a, b) {
+		t.Error("Expected trees with the same seed to have the same shape")
+	}
+}
+
+func TestTreapRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	treap := binarytrees.NewSeededTreap(1)
+	model := make(map[int]bool)
+
+	for step := 0; step < 5000; step++ {
+		v := r.Intn(300)
+		switch op := r.Intn(10); {
+		case op < 6:
+			if treap.Insert(v) == model[v] {
+				t.Fatalf("Expected insert of '%v' to be '%v'", v, !model[v])
+			}
+			model[v] = true
+		case op < 9:
+			if treap.Remove(v) != model[v] {
+				t.Fatalf("Expected remove of '%v' to be '%v'", v, model[v])
+			}
+			delete(model, v)
+		default:
+			hi := v + r.Intn(20)
+			expected := 0
+			for k := range model {
+				if k >= v && k <= hi {
+					delete(model, k)
+					expected++
+				}
+			}
+			if removed := treap.DeleteRange(v, hi); removed != expected {
+				t.Fatalf("Expected delete range [%v, %v] to remove '%v'. Got '%v'", v, hi, expected, removed)
+			}
+		}
+
+		if !treap.Validate() || treap.Len() != len(model) {
+			t.Fatalf("Expected tree to be valid with len '%v'. Got '%v'", len(model), treap.Len())
+		}
+	}
+
+	expected := []int{}
+	for v := range model {
+		expected = append(expected, v)
+	}
+	sort.Ints(expected)
+	if result := treapValues(treap); !equalInts(result, expected) {
+		t.Errorf("Expected in order traversal to be '%v'. Got '%v'", expected, result)
+	}
+}
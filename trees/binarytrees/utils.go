@@ -3,7 +3,7 @@ package binarytrees
 import (
 	"fmt"
 	"io"
-	"math/rand"
+	"time"
 
 	"bytes"
 
@@ -15,13 +15,11 @@ const (
 	PrintNode           = "-["
 )
 
-// NewRandBST returns a new, random binary search tree
+// NewRandBST returns a new, random binary search tree with the values from 1 to length.
+// Use a Generator to build reproducible trees.
 func NewRandBST(length int) *BST {
-	t := &BST{}
-	for _, v := range rand.Perm(length) {
-		t.Insert(1 + v)
-	}
-	return t
+	bst, _ := NewSeededGenerator(time.Now().UnixNano()).Generate(length, Uniform)
+	return bst
 }
 
 // PrintTreeFromNode prints a visual representation of the binary tree from a given node into an io.Writer
@@ -45,7 +43,7 @@ func PrintTreeByLevel(w io.Writer, n *BNode) {
 		return
 	}
 
-	queue := gostrutures.Queue{}
+	queue := gostrutures.TypedQueue[*BNode]{}
 	queue.Push(n)
 	nodesInCurrentLevel, nodesInNextLevel := 1, 0
 
@@ -53,7 +51,7 @@ func PrintTreeByLevel(w io.Writer, n *BNode) {
 		if queue.Size() == 0 {
 			break
 		}
-		node := queue.Pop().(*BNode)
+		node, _ := queue.Pop()
 		fmt.Fprintf(w, "%v ", node.Value)
 		nodesInCurrentLevel--
 		if node.Left != nil {
@@ -78,3 +76,10 @@ func intMax(x, y int) int {
 	}
 	return y
 }
+
+func intMin(x, y int) int {
+	if x < y {
+		return x
+	}
+	return y
+}
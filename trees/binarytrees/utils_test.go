@@ -9,7 +9,7 @@ import (
 func TestNewRandBST(t *testing.T) {
 	bst := binarytrees.NewRandBST(10)
 
-	if bst.Root == nil {
+	if bst.Root() == nil {
 		t.Error("Expected root to be not nil")
 	}
 
@@ -17,3 +17,12 @@ func TestNewRandBST(t *testing.T) {
 		t.Errorf("Expected Len value to be '%v'. Got '%v'", 10, bst.Len())
 	}
 }
+
+func TestNewRandBSTValues(t *testing.T) {
+	bst := binarytrees.NewRandBST(10)
+
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if result := inOrderValues(bst); !equalInts(result, expected) {
+		t.Errorf("Expected in order traversal to be '%v'. Got '%v'", expected, result)
+	}
+}
@@ -0,0 +1,42 @@
+package gostrutures
+
+// TypedQueue is a FIFO queue of values of type T backed by a circular buffer.
+//
+// It has the same methods as Queue, but as it stores T instead of Item the values are
+// not boxed and don't need type assertions. Pop and Peek return the zero value of T
+// and false when the queue is empty. The zero value is an empty queue ready to use.
+//
+// Read/Write operations are not safe for concurrent mutation by multiple goroutines.
+type TypedQueue[T any] struct {
+	ring ring[T]
+}
+
+// NewTypedQueue build an empty TypedQueue.
+func NewTypedQueue[T any]() *TypedQueue[T] {
+	return &TypedQueue[T]{}
+}
+
+// Push adds a value to the end of the queue.
+func (q *TypedQueue[T]) Push(value T) {
+	q.ring.push(value)
+}
+
+// Pop retrieves and removes the head of this queue. Return false if this queue is empty.
+func (q *TypedQueue[T]) Pop() (T, bool) {
+	return q.ring.pop()
+}
+
+// Peek returns but does not remove, the head of this queue. Return false if this queue is empty.
+func (q *TypedQueue[T]) Peek() (T, bool) {
+	return q.ring.peek()
+}
+
+// Size returns the number of values in the queue
+func (q *TypedQueue[T]) Size() int {
+	return q.ring.size
+}
+
+// IsEmpty returns true if the queue is empty
+func (q *TypedQueue[T]) IsEmpty() bool {
+	return q.ring.size == 0
+}
@@ -0,0 +1,105 @@
+package gostrutures_test
+
+import (
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func TestNewTypedQueue(t *testing.T) {
+	q := gostrutures.NewTypedQueue[int]()
+	if q == nil {
+		t.Error("Expected queue to be not nil")
+	}
+}
+
+func TestTypedQueuePushPop(t *testing.T) {
+	tt := []struct {
+		name         string
+		insertValues []int
+	}{
+		{"empty queue", []int{}},
+		{"queue with elements", []int{3, 2, 3, 4}},
+		{"queue that grows", []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := new(gostrutures.TypedQueue[int])
+			for _, value := range tc.insertValues {
+				queue.Push(value)
+			}
+
+			if queue.Size() != len(tc.insertValues) {
+				t.Errorf("Expected queue size to be '%v'. Got '%v'", len(tc.insertValues), queue.Size())
+			}
+
+			for _, expected := range tc.insertValues {
+				result, ok := queue.Pop()
+				if !ok || result != expected {
+					t.Fatalf("Expected pop to be '%v, true'. Got '%v, %v'", expected, result, ok)
+				}
+			}
+
+			if !queue.IsEmpty() {
+				t.Errorf("Expected queue IsEmpty to be '%v'. Got '%v'", true, queue.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestTypedQueuePeek(t *testing.T) {
+	// GIVEN
+	queue := gostrutures.NewTypedQueue[string]()
+	queue.Push("a")
+	queue.Push("b")
+	// When peek
+	result, ok := queue.Peek()
+	// Then
+	if !ok || result != "a" {
+		t.Errorf("Expected peek to be '%v, true'. Got '%v, %v'", "a", result, ok)
+	}
+
+	if queue.Size() != 2 {
+		t.Errorf("Expected queue size after peek to be '%v'. Got '%v'", 2, queue.Size())
+	}
+}
+
+func TestTypedQueueEmpty(t *testing.T) {
+	queue := gostrutures.NewTypedQueue[*int]()
+
+	if result, ok := queue.Pop(); result != nil || ok {
+		t.Errorf("Expected pop to be 'nil, false'. Got '%v, %v'", result, ok)
+	}
+
+	if result, ok := queue.Peek(); result != nil || ok {
+		t.Errorf("Expected peek to be 'nil, false'. Got '%v, %v'", result, ok)
+	}
+}
+
+func TestTypedQueueZeroValues(t *testing.T) {
+	queue := gostrutures.NewTypedQueue[*int]()
+	queue.Push(nil)
+
+	result, ok := queue.Pop()
+	if result != nil || !ok {
+		t.Errorf("Expected pop to be 'nil, true'. Got '%v, %v'", result, ok)
+	}
+
+	if !queue.IsEmpty() {
+		t.Errorf("Expected queue IsEmpty to be '%v'. Got '%v'", true, queue.IsEmpty())
+	}
+}
+
+func BenchmarkTypedQueueSteadyState(b *testing.B) {
+	queue := gostrutures.NewTypedQueue[int]()
+	for i := 0; i < 1000; i++ {
+		queue.Push(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		queue.Push(i)
+		queue.Pop()
+	}
+}
@@ -0,0 +1,132 @@
+package gostrutures
+
+import (
+	"sync/atomic"
+)
+
+// minStealCapacity is the capacity of the buffer of a new WorkStealingDeque.
+const minStealCapacity = 32
+
+// WorkStealingDeque is an unbounded Chase-Lev work-stealing deque. A single owner goroutine
+// pushes and pops at the bottom without locks, in LIFO order, while any number of thief
+// goroutines steal from the top, in FIFO order.
+//
+// Push and Pop must only be called by the owner. Steal and Size can be called from any
+// goroutine; as the deque can change concurrently, Size is a snapshot that may be stale
+// by the time it's used.
+type WorkStealingDeque struct {
+	// top is the index of the next element to steal and bottom the index of the next push,
+	// the elements are the ones in [top, bottom).
+	top    atomic.Int64
+	bottom atomic.Int64
+	buffer atomic.Pointer[stealBuffer]
+}
+
+// stealBuffer is a circular array whose capacity is a power of two. It's never modified when
+// the deque grows, so thieves holding the old buffer still read valid elements.
+type stealBuffer struct {
+	slots []atomic.Pointer[Item]
+	mask  int64
+}
+
+func newStealBuffer(capacity int64) *stealBuffer {
+	return &stealBuffer{slots: make([]atomic.Pointer[Item], capacity), mask: capacity - 1}
+}
+
+func (b *stealBuffer) get(i int64) *Item {
+	return b.slots[i&b.mask].Load()
+}
+
+func (b *stealBuffer) put(i int64, item *Item) {
+	b.slots[i&b.mask].Store(item)
+}
+
+// grow returns a buffer with twice the capacity holding the elements in [top, bottom).
+func (b *stealBuffer) grow(top, bottom int64) *stealBuffer {
+	grown := newStealBuffer(2 * int64(len(b.slots)))
+	for i := top; i < bottom; i++ {
+		grown.put(i, b.get(i))
+	}
+	return grown
+}
+
+// NewWorkStealingDeque build an empty WorkStealingDeque.
+func NewWorkStealingDeque() *WorkStealingDeque {
+	d := &WorkStealingDeque{}
+	d.buffer.Store(newStealBuffer(minStealCapacity))
+	return d
+}
+
+// Push adds an element (Item) to the bottom of the deque. Only the owner can call it.
+func (d *WorkStealingDeque) Push(value Item) {
+	b := d.bottom.Load()
+	t := d.top.Load()
+	buffer := d.buffer.Load()
+	if b-t >= int64(len(buffer.slots)) {
+		buffer = buffer.grow(t, b)
+		d.buffer.Store(buffer)
+	}
+	buffer.put(b, &value)
+	// publish the element to the thieves
+	d.bottom.Store(b + 1)
+}
+
+// Pop retrieves and removes the element at the bottom of the deque, the last pushed. Return
+// false if the deque is empty. Only the owner can call it.
+func (d *WorkStealingDeque) Pop() (Item, bool) {
+	b := d.bottom.Load() - 1
+	buffer := d.buffer.Load()
+	// reserve the bottom element before looking at the thieves
+	d.bottom.Store(b)
+	t := d.top.Load()
+
+	if t > b {
+		// the deque was empty
+		d.bottom.Store(b + 1)
+		return nil, false
+	}
+
+	item := buffer.get(b)
+	if t == b {
+		// last element, race against the thieves for it
+		won := d.top.CompareAndSwap(t, t+1)
+		d.bottom.Store(b + 1)
+		if !won {
+			return nil, false
+		}
+	}
+	return *item, true
+}
+
+// Steal retrieves and removes the element at the top of the deque, the first pushed. Return
+// false if the deque is empty. It's safe to call it from any goroutine.
+func (d *WorkStealingDeque) Steal() (Item, bool) {
+	for {
+		t := d.top.Load()
+		b := d.bottom.Load()
+		if t >= b {
+			return nil, false
+		}
+
+		item := d.buffer.Load().get(t)
+		if d.top.CompareAndSwap(t, t+1) {
+			return *item, true
+		}
+		// another thief or the owner took it, try with the next one
+	}
+}
+
+// Size returns the number of Items in the deque
+func (d *WorkStealingDeque) Size() int {
+	size := d.bottom.Load() - d.top.Load()
+	if size < 0 {
+		// the owner is in the middle of a Pop of an empty deque
+		return 0
+	}
+	return int(size)
+}
+
+// IsEmpty returns true if the deque is empty
+func (d *WorkStealingDeque) IsEmpty() bool {
+	return d.Size() == 0
+}
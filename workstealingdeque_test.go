@@ -0,0 +1,181 @@
+package gostrutures_test
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ifreddyrondon/gostrutures"
+)
+
+func TestWorkStealingDequeOwnerAndThief(t *testing.T) {
+	tt := []struct {
+		name     string
+		take     func(d *gostrutures.WorkStealingDeque) (gostrutures.Item, bool)
+		expected []gostrutures.Item
+	}{
+		{"owner pops in LIFO order", (*gostrutures.WorkStealingDeque).Pop, []gostrutures.Item{3, nil, 1}},
+		{"thief steals in FIFO order", (*gostrutures.WorkStealingDeque).Steal, []gostrutures.Item{1, nil, 3}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			d := gostrutures.NewWorkStealingDeque()
+			for _, v := range []gostrutures.Item{1, nil, 3} {
+				d.Push(v)
+			}
+
+			if d.Size() != 3 {
+				t.Errorf("Expected deque size to be '%v'. Got '%v'", 3, d.Size())
+			}
+
+			for _, expected := range tc.expected {
+				if result, ok := tc.take(d); result != expected || !ok {
+					t.Errorf("Expected element to be '%v, true'. Got '%v, %v'", expected, result, ok)
+				}
+			}
+
+			if result, ok := tc.take(d); ok {
+				t.Errorf("Expected take from an empty deque to be false. Got '%v'", result)
+			}
+
+			if !d.IsEmpty() {
+				t.Errorf("Expected deque IsEmpty to be '%v'. Got '%v'", true, d.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestWorkStealingDequeGrow(t *testing.T) {
+	d := gostrutures.NewWorkStealingDeque()
+	for i := 0; i < 10; i++ {
+		d.Push(i)
+	}
+	// move the top so the elements wrap around the buffer while it grows
+	for i := 0; i < 5; i++ {
+		d.Steal()
+	}
+	for i := 10; i < 1000; i++ {
+		d.Push(i)
+	}
+
+	for i := 5; i < 500; i++ {
+		if result, _ := d.Steal(); result != i {
+			t.Fatalf("Expected steal to be '%v'. Got '%v'", i, result)
+		}
+	}
+	for i := 999; i >= 500; i-- {
+		if result, _ := d.Pop(); result != i {
+			t.Fatalf("Expected pop to be '%v'. Got '%v'", i, result)
+		}
+	}
+}
+
+func TestWorkStealingDequeConcurrentSteal(t *testing.T) {
+	const thieves, elements = 8, 100000
+	d := gostrutures.NewWorkStealingDeque()
+	taken := make([]atomic.Int32, elements)
+	take := func(item gostrutures.Item) {
+		taken[item.(int)].Add(1)
+	}
+
+	var done atomic.Bool
+	var wg sync.WaitGroup
+	for i := 0; i < thieves; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !done.Load() || !d.IsEmpty() {
+				if item, ok := d.Steal(); ok {
+					take(item)
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	// the owner interleaves pushes and pops so it races with the thieves for the last elements
+	for i := 0; i < elements; i++ {
+		d.Push(i)
+		if i%3 == 0 {
+			if item, ok := d.Pop(); ok {
+				take(item)
+			}
+		}
+	}
+	for item, ok := d.Pop(); ok; item, ok = d.Pop() {
+		take(item)
+	}
+	done.Store(true)
+	wg.Wait()
+
+	for i := range taken {
+		if n := taken[i].Load(); n != 1 {
+			t.Fatalf("Expected element '%v' to be taken once. Got '%v' times", i, n)
+		}
+	}
+}
+
+// ExampleWorkStealingDeque is a reference scheduler where every worker runs the tasks of its
+// own deque, steals from the others when it's empty, and pushes the subtasks it spawns.
+func ExampleWorkStealingDeque() {
+	type task func(spawn func(task))
+
+	const workers = 4
+	deques := make([]*gostrutures.WorkStealingDeque, workers)
+	for i := range deques {
+		deques[i] = gostrutures.NewWorkStealingDeque()
+	}
+
+	// pending counts the tasks not finished yet, the workers stop when it's zero
+	var pending, sum atomic.Int64
+	var sumTo func(from, to int) task
+	sumTo = func(from, to int) task {
+		return func(spawn func(task)) {
+			if to-from <= 100 {
+				for i := from; i <= to; i++ {
+					sum.Add(int64(i))
+				}
+				return
+			}
+			mid := (from + to) / 2
+			spawn(sumTo(from, mid))
+			spawn(sumTo(mid+1, to))
+		}
+	}
+
+	pending.Add(1)
+	deques[0].Push(sumTo(1, 100000))
+
+	var wg sync.WaitGroup
+	for id := range deques {
+		wg.Add(1)
+		go func(own *gostrutures.WorkStealingDeque) {
+			defer wg.Done()
+			spawn := func(t task) {
+				pending.Add(1)
+				own.Push(t)
+			}
+
+			for pending.Load() > 0 {
+				item, ok := own.Pop()
+				for victim := 0; !ok && victim < workers; victim++ {
+					item, ok = deques[victim].Steal()
+				}
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				item.(task)(spawn)
+				pending.Add(-1)
+			}
+		}(deques[id])
+	}
+	wg.Wait()
+
+	fmt.Println(sum.Load())
+	// Output: 5000050000
+}